@@ -0,0 +1,15 @@
+# List the app API keys of a user and when they expire.
+data "kion_app_api_key" "ci" {
+  filter {
+    name   = "user_id"
+    values = ["12"]
+  }
+}
+
+output "ci_key_expiry" {
+  value = {
+    for key in data.kion_app_api_key.ci.list :
+    key.name => key.expiry
+  }
+  description = "Expiry of the CI user's app API keys by name"
+}
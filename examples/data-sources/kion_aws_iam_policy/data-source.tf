@@ -11,12 +11,6 @@ data "kion_aws_iam_policy" "aws_managed" {
   policy_type = "aws"
 }
 
-# Use v4 pagination
-data "kion_aws_iam_policy" "paginated" {
-  page      = 1
-  page_size = 50
-}
-
 # Combine v4 filtering with existing filter blocks
 data "kion_aws_iam_policy" "combined" {
   query       = "Admin"
@@ -134,13 +128,7 @@ data "kion_aws_iam_policy" "user_policies" {
   policy_type = "user"
 }
 
-# Example 4: Use pagination
-data "kion_aws_iam_policy" "paginated" {
-  page      = 1
-  page_size = 10
-}
-
-# Example 5: Filter policies by name
+# Example 4: Filter policies by name
 data "kion_aws_iam_policy" "by_name" {
   filter {
     name   = "name"
@@ -148,7 +136,7 @@ data "kion_aws_iam_policy" "by_name" {
   }
 }
 
-# Example 6: Filter policies by multiple criteria
+# Example 5: Filter policies by multiple criteria
 data "kion_aws_iam_policy" "multi_filter" {
   filter {
     name   = "name"
@@ -162,7 +150,7 @@ data "kion_aws_iam_policy" "multi_filter" {
   }
 }
 
-# Example 7: Filter by owner
+# Example 6: Filter by owner
 data "kion_aws_iam_policy" "by_owner" {
   filter {
     name   = "owner_users.id"
@@ -170,7 +158,7 @@ data "kion_aws_iam_policy" "by_owner" {
   }
 }
 
-# Example 8: Combine query, policy type and filter
+# Example 7: Combine query, policy type and filter
 data "kion_aws_iam_policy" "combined" {
   query       = "S3"
   policy_type = "aws"
@@ -0,0 +1,15 @@
+# Output a one-click console link for an account provisioned by this
+# configuration. A new link is generated on every plan and apply.
+data "kion_console_url" "sandbox" {
+  account_id           = kion_aws_account.sandbox.id
+  cloud_access_role_id = kion_project_cloud_access_role.developer.id
+}
+
+output "sandbox_console_url" {
+  value     = data.kion_console_url.sandbox.url
+  sensitive = true
+}
+
+output "sandbox_console_url_expiration" {
+  value = data.kion_console_url.sandbox.expiration
+}
@@ -0,0 +1,23 @@
+# Find the permission schemes that can be used for projects.
+data "kion_permission_scheme" "project_schemes" {
+  filter {
+    name   = "scheme_type"
+    values = ["project"]
+  }
+}
+
+# Find a permission scheme by name.
+data "kion_permission_scheme" "default_ou" {
+  filter {
+    name   = "name"
+    values = ["Default OU Permission Scheme"]
+  }
+}
+
+output "project_scheme_ids" {
+  value = {
+    for scheme in data.kion_permission_scheme.project_schemes.list :
+    scheme.name => scheme.id
+  }
+  description = "IDs of the project permission schemes by name"
+}
@@ -40,6 +40,31 @@ data "kion_project" "archived_projects" {
   }
 }
 
+# Find active projects named "Sandbox ..." in any case that have a description
+# and an ID above 100
+data "kion_project" "sandbox_projects" {
+  filter {
+    name             = "name"
+    values           = ["sandbox"]
+    operator         = "prefix"
+    case_insensitive = true
+  }
+  filter {
+    name     = "archived"
+    values   = ["true"]
+    operator = "not_equals"
+  }
+  filter {
+    name     = "description"
+    operator = "exists"
+  }
+  filter {
+    name     = "id"
+    values   = ["100"]
+    operator = "gt"
+  }
+}
+
 # Output project information
 output "development_projects" {
   value = {
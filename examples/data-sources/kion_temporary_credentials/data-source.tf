@@ -0,0 +1,13 @@
+# For Terraform versions before 1.10, which have no ephemeral resources. The
+# credentials are stored in the state, so protect it accordingly.
+data "kion_temporary_credentials" "deploy" {
+  account_number    = "111122223333"
+  aws_iam_role_name = "deploy"
+}
+
+provider "aws" {
+  region     = "us-east-1"
+  access_key = data.kion_temporary_credentials.deploy.access_key_id
+  secret_key = data.kion_temporary_credentials.deploy.secret_access_key
+  token      = data.kion_temporary_credentials.deploy.session_token
+}
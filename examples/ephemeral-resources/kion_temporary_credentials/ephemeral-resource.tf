@@ -0,0 +1,26 @@
+# Configure the AWS provider with temporary credentials of a cloud access role
+# defined in the same configuration. The credentials never reach the state.
+resource "kion_project_cloud_access_role" "deploy" {
+  name              = "deploy"
+  project_id        = 12
+  aws_iam_role_name = "deploy"
+
+  accounts {
+    id = 34
+  }
+  users {
+    id = 1
+  }
+}
+
+ephemeral "kion_temporary_credentials" "deploy" {
+  account_number    = "111122223333"
+  aws_iam_role_name = kion_project_cloud_access_role.deploy.aws_iam_role_name
+}
+
+provider "aws" {
+  region     = "us-east-1"
+  access_key = ephemeral.kion_temporary_credentials.deploy.access_key_id
+  secret_key = ephemeral.kion_temporary_credentials.deploy.secret_access_key
+  token      = ephemeral.kion_temporary_credentials.deploy.session_token
+}
@@ -0,0 +1,17 @@
+# Create an app API key for a CI service account and rotate it every 60 days.
+# The new key is created before the old one is revoked.
+resource "kion_app_api_key" "ci" {
+  name          = "ci-pipeline"
+  user_id       = kion_user.ci.id
+  rotation_days = 60
+
+  # Change a value to rotate the key immediately.
+  rotate_when_changed = {
+    reason = "initial"
+  }
+}
+
+output "ci_api_key" {
+  value     = kion_app_api_key.ci.key
+  sensitive = true
+}
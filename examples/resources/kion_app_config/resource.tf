@@ -47,9 +47,13 @@ resource "kion_app_config" "main" {
   smtp_port        = 587
   smtp_from        = "kion-notifications@company.com"
   smtp_username    = "kion-service"
-  smtp_password    = var.smtp_password  # Use a variable for sensitive data
   smtp_skip_verify = false
 
+  # The password is never stored in state (Terraform 1.11+). Bump the version
+  # to send a new password.
+  smtp_password_wo         = var.smtp_password
+  smtp_password_wo_version = 1
+
   # Debug and development settings
   saml_debug = false
 }
@@ -0,0 +1,15 @@
+# Attach an existing label to a project by its ID, leaving the labels
+# managed elsewhere in place.
+resource "kion_label_association" "project_team" {
+  resource_type = "project"
+  resource_id   = 42
+  label_id      = 12
+}
+
+# Attach a label by key and value.
+resource "kion_label_association" "ou_environment" {
+  resource_type = "ou"
+  resource_id   = 7
+  key           = "Environment"
+  value         = "Production"
+}
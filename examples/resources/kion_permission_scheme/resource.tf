@@ -0,0 +1,27 @@
+# Create an OU permission scheme that grants permissions to the Owner and
+# Viewer app roles, and use it for an OU.
+resource "kion_permission_scheme" "ou_operators" {
+  name        = "OU Operators"
+  description = "Owners manage the OU, viewers only read it."
+  scheme_type = "ou"
+
+  grant {
+    app_role_id    = 1 # Owner
+    permission_ids = [1, 2, 3, 4]
+  }
+
+  grant {
+    app_role_id    = 2 # Viewer
+    permission_ids = [1]
+  }
+}
+
+resource "kion_ou" "engineering" {
+  name                 = "Engineering"
+  parent_ou_id         = 0
+  permission_scheme_id = kion_permission_scheme.ou_operators.id
+
+  owner_users {
+    id = 1
+  }
+}
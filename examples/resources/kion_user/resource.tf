@@ -1,2 +1,19 @@
-# Note: The kion_user resource is currently read-only and cannot be created or modified directly.
-# Users are typically managed through your Identity Management System (IDMS).
+# Create a local break-glass user that must choose a new password at the
+# first login.
+resource "kion_user" "break_glass" {
+  username   = "break-glass"
+  first_name = "Break"
+  last_name  = "Glass"
+  email      = "break-glass@example.com"
+
+  # The password is never stored in state (Terraform 1.11+). Bump the version
+  # to set a new password.
+  password_wo         = var.break_glass_initial_password
+  password_wo_version = 1
+
+  # Keep the user and its history in Kion when it is removed from Terraform.
+  disable_on_destroy = true
+}
+
+# Users are imported by ID or by username, e.g.:
+# terraform import kion_user.break_glass break-glass
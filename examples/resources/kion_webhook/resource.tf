@@ -82,6 +82,22 @@ resource "kion_webhook" "project_lifecycle" {
   }
 }
 
+# Send a bearer token without storing it in state (Terraform 1.11+). Bump
+# request_headers_wo_version to send new headers.
+resource "kion_webhook" "ticketing" {
+  name               = "Ticketing"
+  callout_url        = "https://tickets.example.com/api/kion"
+  request_method     = "POST"
+  timeout_in_seconds = 30
+  owner_user_ids     = [32]
+
+  use_request_headers = true
+  request_headers_wo = jsonencode({
+    Authorization = "Bearer ${var.ticketing_token}"
+  })
+  request_headers_wo_version = 1
+}
+
 # Output webhook information
 output "webhook_configs" {
   value = {
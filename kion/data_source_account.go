@@ -3,12 +3,11 @@ package kion
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
 )
 
@@ -32,10 +31,23 @@ func dataSourceAccount() *schema.Resource {
 							Optional:    true,
 							Default:     false,
 						},
+						"operator": {
+							Description:  "How the field is compared with the values: equals (default), not_equals, contains, prefix, in, gt, gte, lt and lte for numbers and dates, or exists and not_exists, which take no values.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      hc.FilterEquals,
+							ValidateFunc: validation.StringInSlice(hc.FilterOperators, false),
+						},
+						"case_insensitive": {
+							Description: "Dictates if the values should be compared regardless of case.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
 						"values": {
 							Description: "The values of the field name you specified.",
 							Type:        schema.TypeList,
-							Required:    true,
+							Optional:    true,
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
 					},
@@ -129,8 +141,8 @@ func dataSourceAccountRead(ctx context.Context, d *schema.ResourceData, m interf
 	tflog.Debug(ctx, "Reading accounts list")
 
 	resp := new(hc.AccountListResponse)
-	if err := client.GET("/v3/account", resp); err != nil {
-		return append(diags, hc.HandleError(fmt.Errorf("failed to read accounts: %v", err))...)
+	if err := hc.ListInto(ctx, client, "/v3/account", nil, &resp.Data); err != nil {
+		return append(diags, hc.HandleError(fmt.Errorf("failed to read accounts: %w", err))...)
 	}
 
 	f := hc.NewFilterable(d)
@@ -159,7 +171,7 @@ func dataSourceAccountRead(ctx context.Context, d *schema.ResourceData, m interf
 
 		match, err := f.Match(data)
 		if err != nil {
-			return append(diags, hc.HandleError(fmt.Errorf("failed to filter accounts: %v", err))...)
+			return append(diags, hc.HandleError(fmt.Errorf("failed to filter accounts: %w", err))...)
 		}
 		if !match {
 			continue
@@ -170,8 +182,7 @@ func dataSourceAccountRead(ctx context.Context, d *schema.ResourceData, m interf
 
 	diags = append(diags, hc.SafeSet(d, "list", arr, "Failed to set accounts list")...)
 
-	// Always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.SetId(hc.DataSourceID(d, "filter"))
 
 	return diags
 }
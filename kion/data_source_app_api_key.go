@@ -0,0 +1,143 @@
+package kion
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
+)
+
+func dataSourceAppAPIKey() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists app API keys and when they expire. The secrets of existing keys cannot be read.",
+		ReadContext: dataSourceAppAPIKeyRead,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The field name whose values you wish to filter by.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"values": {
+							Description: "The values of the field name you specified.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"regex": {
+							Description: "Dictates if the values provided should be treated as regular expressions.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+						"operator": {
+							Description:  "How the field is compared with the values: equals (default), not_equals, contains, prefix, in, gt, gte, lt and lte for numbers and dates, or exists and not_exists, which take no values.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      hc.FilterEquals,
+							ValidateFunc: validation.StringInSlice(hc.FilterOperators, false),
+						},
+						"case_insensitive": {
+							Description: "Dictates if the values should be compared regardless of case.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+			"list": {
+				Description: "This is where Kion makes the discovered data available as a list of resources.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expiry": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAppAPIKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*hc.Client)
+
+	resp := new(hc.AppAPIKeyListResponse)
+	err := hc.ListInto(ctx, client, "/v3/app-api-key", nil, &resp.Data)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read AppAPIKey",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), "all"),
+		})
+		return diags
+	}
+
+	f := hc.NewFilterable(d)
+
+	arr := make([]map[string]interface{}, 0)
+	for _, item := range resp.Data {
+		data := make(map[string]interface{})
+
+		data["id"] = item.ID
+		data["created_at"] = item.CreatedAt
+		data["expiry"] = item.Expiry
+		data["name"] = item.Name
+		data["user_id"] = item.UserID
+
+		match, err := f.Match(data)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to filter AppAPIKey",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), "filter"),
+			})
+			return diags
+		} else if !match {
+			continue
+		}
+
+		arr = append(arr, data)
+	}
+
+	if err := d.Set("list", arr); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read AppAPIKey",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), "all"),
+		})
+		return diags
+	}
+
+	d.SetId(hc.DataSourceID(d, "filter"))
+
+	return diags
+}
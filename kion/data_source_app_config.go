@@ -2,8 +2,6 @@ package kion
 
 import (
 	"context"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -171,7 +169,7 @@ func dataSourceAppConfigRead(ctx context.Context, d *schema.ResourceData, m inte
 	var diags diag.Diagnostics
 
 	var reqPayload hc.AppConfigResponse
-	err := client.GET("/v3/app-config", &reqPayload)
+	err := client.GETContext(ctx, "/v3/app-config", &reqPayload)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -214,7 +212,7 @@ func dataSourceAppConfigRead(ctx context.Context, d *schema.ResourceData, m inte
 	diags = append(diags, hc.SafeSet(d, "supported_aws_regions", appConfig.SupportedAWSRegions, "Error setting supported_aws_regions")...)
 
 	// Use a static ID since there's only one app config
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.SetId("app-config")
 
 	return diags
 }
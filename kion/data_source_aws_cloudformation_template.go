@@ -3,11 +3,10 @@ package kion
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
 )
 
@@ -28,7 +27,7 @@ func dataSourceAwsCloudformationTemplate() *schema.Resource {
 						"values": {
 							Description: "The values of the field name you specified.",
 							Type:        schema.TypeList,
-							Required:    true,
+							Optional:    true,
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
 						"regex": {
@@ -37,6 +36,19 @@ func dataSourceAwsCloudformationTemplate() *schema.Resource {
 							Optional:    true,
 							Default:     false,
 						},
+						"operator": {
+							Description:  "How the field is compared with the values: equals (default), not_equals, contains, prefix, in, gt, gte, lt and lte for numbers and dates, or exists and not_exists, which take no values.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      hc.FilterEquals,
+							ValidateFunc: validation.StringInSlice(hc.FilterOperators, false),
+						},
+						"case_insensitive": {
+							Description: "Dictates if the values should be compared regardless of case.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
 					},
 				},
 			},
@@ -124,7 +136,7 @@ func dataSourceAwsCloudformationTemplateRead(ctx context.Context, d *schema.Reso
 	client := m.(*hc.Client)
 
 	resp := new(hc.CFTListResponseWithOwnersAndTags)
-	err := client.GET("/v3/cft", resp)
+	err := hc.ListInto(ctx, client, "/v3/cft", nil, &resp.Data)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -176,8 +188,7 @@ func dataSourceAwsCloudformationTemplateRead(ctx context.Context, d *schema.Reso
 		return diags
 	}
 
-	// Always run.
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.SetId(hc.DataSourceID(d, "filter"))
 
 	return diags
 }
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -29,7 +28,7 @@ func dataSourceAwsIamPolicy() *schema.Resource {
 						"values": {
 							Description: "The values of the field name you specified.",
 							Type:        schema.TypeList,
-							Required:    true,
+							Optional:    true,
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
 						"regex": {
@@ -38,6 +37,19 @@ func dataSourceAwsIamPolicy() *schema.Resource {
 							Optional:    true,
 							Default:     false,
 						},
+						"operator": {
+							Description:  "How the field is compared with the values: equals (default), not_equals, contains, prefix, in, gt, gte, lt and lte for numbers and dates, or exists and not_exists, which take no values.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      hc.FilterEquals,
+							ValidateFunc: validation.StringInSlice(hc.FilterOperators, false),
+						},
+						"case_insensitive": {
+							Description: "Dictates if the values should be compared regardless of case.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
 					},
 				},
 			},
@@ -124,12 +136,14 @@ func dataSourceAwsIamPolicy() *schema.Resource {
 			"page": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "Page number of results",
+				Description: "Page number of results. When set, only this page is returned instead of every policy.",
+				Deprecated:  "All pages are now fetched automatically. Set page only to limit the results to a single page.",
 			},
 			"page_size": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "Number of results per page",
+				Description: "Number of results per page. Only used together with page.",
+				Deprecated:  "All pages are now fetched automatically. Set page_size only together with page.",
 			},
 		},
 	}
@@ -156,7 +170,7 @@ func dataSourceAwsIamPolicyRead(ctx context.Context, d *schema.ResourceData, m i
 		}
 	}
 
-	err := client.GETWithParams("/v4/iam-policy", params, respV4)
+	err := hc.ListInto(ctx, client, "/v4/iam-policy", params, &respV4.Data.Items)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -211,8 +225,7 @@ func processV4Response(d *schema.ResourceData, resp *hc.IAMPolicyV4ListResponse)
 		return diags
 	}
 
-	// Always run.
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.SetId(hc.DataSourceID(d, "filter", "query", "policy_type", "page", "page_size"))
 
 	return diags
 }
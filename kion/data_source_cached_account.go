@@ -3,12 +3,11 @@ package kion
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
 )
 
@@ -32,10 +31,23 @@ func dataSourceCachedAccount() *schema.Resource {
 							Optional:    true,
 							Default:     false,
 						},
+						"operator": {
+							Description:  "How the field is compared with the values: equals (default), not_equals, contains, prefix, in, gt, gte, lt and lte for numbers and dates, or exists and not_exists, which take no values.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      hc.FilterEquals,
+							ValidateFunc: validation.StringInSlice(hc.FilterOperators, false),
+						},
+						"case_insensitive": {
+							Description: "Dictates if the values should be compared regardless of case.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
 						"values": {
 							Description: "The values of the field name you specified.",
 							Type:        schema.TypeList,
-							Required:    true,
+							Optional:    true,
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
 					},
@@ -129,8 +141,8 @@ func dataSourceCachedAccountRead(ctx context.Context, d *schema.ResourceData, m
 	tflog.Debug(ctx, "Reading cached accounts list")
 
 	resp := new(hc.AccountCacheListResponse)
-	if err := client.GET("/v3/account-cache", resp); err != nil {
-		return append(diags, hc.HandleError(fmt.Errorf("failed to read cached accounts: %v", err))...)
+	if err := hc.ListInto(ctx, client, "/v3/account-cache", nil, &resp.Data); err != nil {
+		return append(diags, hc.HandleError(fmt.Errorf("failed to read cached accounts: %w", err))...)
 	}
 
 	f := hc.NewFilterable(d)
@@ -156,7 +168,7 @@ func dataSourceCachedAccountRead(ctx context.Context, d *schema.ResourceData, m
 
 		match, err := f.Match(data)
 		if err != nil {
-			return append(diags, hc.HandleError(fmt.Errorf("failed to filter cached accounts: %v", err))...)
+			return append(diags, hc.HandleError(fmt.Errorf("failed to filter cached accounts: %w", err))...)
 		}
 		if !match {
 			continue
@@ -167,8 +179,7 @@ func dataSourceCachedAccountRead(ctx context.Context, d *schema.ResourceData, m
 
 	diags = append(diags, hc.SafeSet(d, "list", arr, "Failed to set cached accounts list")...)
 
-	// Always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.SetId(hc.DataSourceID(d, "filter"))
 
 	return diags
 }
@@ -0,0 +1,68 @@
+package kion
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
+)
+
+// dataSourceConsoleURL returns a federated web console link. A new link is
+// generated every time the data source is read.
+func dataSourceConsoleURL() *schema.Resource {
+	return &schema.Resource{
+		Description: "A time-limited federated link to the web console of an account, signed in with a cloud access role. " +
+			"The link grants access until it expires and is stored in the state.",
+		ReadContext: dataSourceConsoleURLRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the account in Kion.",
+			},
+			"cloud_access_role_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of a kion_ou_cloud_access_role or kion_project_cloud_access_role that applies to the account.",
+			},
+			"expiration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the link expires.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The console sign-in link.",
+			},
+		},
+	}
+}
+
+func dataSourceConsoleURLRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*hc.Client)
+	accountID := d.Get("account_id").(int)
+	carID := d.Get("cloud_access_role_id").(int)
+	ID := fmt.Sprintf("%d/%d", accountID, carID)
+
+	consoleURL, err := hc.GetConsoleURL(ctx, client, accountID, carID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to get console URL",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	diags = append(diags, hc.SafeSet(d, "expiration", consoleURL.Expiration, "Unable to set expiration")...)
+	diags = append(diags, hc.SafeSet(d, "url", consoleURL.URL, "Unable to set url")...)
+
+	d.SetId(ID)
+
+	return diags
+}
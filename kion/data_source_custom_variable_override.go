@@ -3,11 +3,10 @@ package kion
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
 )
 
@@ -28,7 +27,7 @@ func dataSourceCustomVariableOverride() *schema.Resource {
 						"values": {
 							Description: "The values of the field name you specified.",
 							Type:        schema.TypeList,
-							Required:    true,
+							Optional:    true,
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
 						"regex": {
@@ -37,6 +36,19 @@ func dataSourceCustomVariableOverride() *schema.Resource {
 							Optional:    true,
 							Default:     false,
 						},
+						"operator": {
+							Description:  "How the field is compared with the values: equals (default), not_equals, contains, prefix, in, gt, gte, lt and lte for numbers and dates, or exists and not_exists, which take no values.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      hc.FilterEquals,
+							ValidateFunc: validation.StringInSlice(hc.FilterOperators, false),
+						},
+						"case_insensitive": {
+							Description: "Dictates if the values should be compared regardless of case.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
 					},
 				},
 			},
@@ -87,41 +99,41 @@ func dataSourceCustomVariableOverrideRead(ctx context.Context, d *schema.Resourc
 	client := m.(*hc.Client)
 	var arr []map[string]interface{}
 
-	ouOverrides, errDiags := getAllOUOverrides(d, client)
+	ouOverrides, errDiags := getAllOUOverrides(ctx, d, client)
 	if errDiags != nil {
 		return errDiags
 	}
 	arr = append(arr, ouOverrides...)
 
-	projectOverrides, errDiags := getAllProjectOverrides(d, client)
+	projectOverrides, errDiags := getAllProjectOverrides(ctx, d, client)
 	if errDiags != nil {
 		return errDiags
 	}
 	arr = append(arr, projectOverrides...)
 
-	accountOverrides, errDiags := getAllAccountOverrides(d, client)
+	accountOverrides, errDiags := getAllAccountOverrides(ctx, d, client)
 	if errDiags != nil {
 		return errDiags
 	}
 	arr = append(arr, accountOverrides...)
 
-	accountCacheOverrides, errDiags := getAllAccountCacheOverrides(d, client)
+	accountCacheOverrides, errDiags := getAllAccountCacheOverrides(ctx, d, client)
 	if errDiags != nil {
 		return errDiags
 	}
 	arr = append(arr, accountCacheOverrides...)
 
 	diags = append(diags, hc.SafeSet(d, "list", arr, "Failed to set list")...)
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.SetId(hc.DataSourceID(d, "filter"))
 
 	return diags
 }
 
-func getAllOUOverrides(d *schema.ResourceData, client *hc.Client) ([]map[string]interface{}, diag.Diagnostics) {
+func getAllOUOverrides(ctx context.Context, d *schema.ResourceData, client *hc.Client) ([]map[string]interface{}, diag.Diagnostics) {
 	var ous hc.OUListResponse
-	err := client.GET("/v3/ou", &ous)
+	err := hc.ListInto(ctx, client, "/v3/ou", nil, &ous.Data)
 	if err != nil {
-		return nil, hc.HandleError(fmt.Errorf("error getting OUs: %v", err))
+		return nil, hc.HandleError(fmt.Errorf("error getting OUs: %w", err))
 	}
 
 	f := hc.NewFilterable(d)
@@ -129,9 +141,9 @@ func getAllOUOverrides(d *schema.ResourceData, client *hc.Client) ([]map[string]
 
 	for _, ou := range ous.Data {
 		var overrides hc.CustomVariableOverrideListResponse
-		err := client.GET(fmt.Sprintf("/v3/ou/%d/custom-variable?count=999999", ou.ID), &overrides)
+		err := hc.ListInto(ctx, client, fmt.Sprintf("/v3/ou/%d/custom-variable", ou.ID), nil, &overrides.Data.Items)
 		if err != nil {
-			return nil, hc.HandleError(fmt.Errorf("error getting OU overrides: %v", err))
+			return nil, hc.HandleError(fmt.Errorf("error getting OU overrides: %w", err))
 		}
 
 		for _, override := range overrides.Data.Items {
@@ -140,14 +152,14 @@ func getAllOUOverrides(d *schema.ResourceData, client *hc.Client) ([]map[string]
 			}
 
 			cvResp := new(hc.CustomVariableResponse)
-			err := client.GET(fmt.Sprintf("/v3/custom-variable/%d", override.CustomVariableID), cvResp)
+			err := client.GETContext(ctx, fmt.Sprintf("/v3/custom-variable/%d", override.CustomVariableID), cvResp)
 			if err != nil {
-				return nil, hc.HandleError(fmt.Errorf("failed to get custom variable type: %v", err))
+				return nil, hc.HandleError(fmt.Errorf("failed to get custom variable type: %w", err))
 			}
 
 			cvValueStr, err := hc.PackCvValueIntoJSONStr(override.Override.Value, cvResp.Data.Type)
 			if err != nil {
-				return nil, hc.HandleError(fmt.Errorf("failed to process value: %v", err))
+				return nil, hc.HandleError(fmt.Errorf("failed to process value: %w", err))
 			}
 
 			data := map[string]interface{}{
@@ -159,7 +171,7 @@ func getAllOUOverrides(d *schema.ResourceData, client *hc.Client) ([]map[string]
 
 			match, err := f.Match(data)
 			if err != nil {
-				return nil, hc.HandleError(fmt.Errorf("unable to filter Custom Variables: %v", err))
+				return nil, hc.HandleError(fmt.Errorf("unable to filter Custom Variables: %w", err))
 			} else if !match {
 				continue
 			}
@@ -171,36 +183,36 @@ func getAllOUOverrides(d *schema.ResourceData, client *hc.Client) ([]map[string]
 	return arr, nil
 }
 
-func getAllProjectOverrides(d *schema.ResourceData, client *hc.Client) ([]map[string]interface{}, diag.Diagnostics) {
+func getAllProjectOverrides(ctx context.Context, d *schema.ResourceData, client *hc.Client) ([]map[string]interface{}, diag.Diagnostics) {
 	var projects hc.ProjectListResponse
-	err := client.GET("/v3/project", &projects)
+	err := hc.ListInto(ctx, client, "/v3/project", nil, &projects.Data)
 	if err != nil {
-		return nil, hc.HandleError(fmt.Errorf("error getting projects: %v", err))
+		return nil, hc.HandleError(fmt.Errorf("error getting projects: %w", err))
 	}
 
 	f := hc.NewFilterable(d)
 	var arr []map[string]interface{}
 	for _, project := range projects.Data {
 		var overrides hc.CustomVariableOverrideListResponse
-		err := client.GET(fmt.Sprintf("/v3/project/%d/custom-variable?count=999999", project.ID), &overrides)
+		err := hc.ListInto(ctx, client, fmt.Sprintf("/v3/project/%d/custom-variable", project.ID), nil, &overrides.Data.Items)
 		if err != nil {
-			return nil, hc.HandleError(fmt.Errorf("error getting project overrides: %v", err))
+			return nil, hc.HandleError(fmt.Errorf("error getting project overrides: %w", err))
 		}
 
 		for _, override := range overrides.Data.Items {
-			if override.Override.Value == nil {
+			if override.Override == nil || override.Override.Value == nil {
 				continue
 			}
 
 			cvResp := new(hc.CustomVariableResponse)
-			err := client.GET(fmt.Sprintf("/v3/custom-variable/%d", override.CustomVariableID), cvResp)
+			err := client.GETContext(ctx, fmt.Sprintf("/v3/custom-variable/%d", override.CustomVariableID), cvResp)
 			if err != nil {
-				return nil, hc.HandleError(fmt.Errorf("failed to get custom variable type: %v", err))
+				return nil, hc.HandleError(fmt.Errorf("failed to get custom variable type: %w", err))
 			}
 
 			cvValueStr, err := hc.PackCvValueIntoJSONStr(override.Override.Value, cvResp.Data.Type)
 			if err != nil {
-				return nil, hc.HandleError(fmt.Errorf("failed to process value: %v", err))
+				return nil, hc.HandleError(fmt.Errorf("failed to process value: %w", err))
 			}
 
 			data := map[string]interface{}{
@@ -212,7 +224,7 @@ func getAllProjectOverrides(d *schema.ResourceData, client *hc.Client) ([]map[st
 
 			match, err := f.Match(data)
 			if err != nil {
-				return nil, hc.HandleError(fmt.Errorf("unable to filter Custom Variables: %v", err))
+				return nil, hc.HandleError(fmt.Errorf("unable to filter Custom Variables: %w", err))
 			}
 			if !match {
 				continue
@@ -225,36 +237,36 @@ func getAllProjectOverrides(d *schema.ResourceData, client *hc.Client) ([]map[st
 	return arr, nil
 }
 
-func getAllAccountOverrides(d *schema.ResourceData, client *hc.Client) ([]map[string]interface{}, diag.Diagnostics) {
+func getAllAccountOverrides(ctx context.Context, d *schema.ResourceData, client *hc.Client) ([]map[string]interface{}, diag.Diagnostics) {
 	var accounts hc.AccountListResponse
-	err := client.GET("/v3/account", &accounts)
+	err := hc.ListInto(ctx, client, "/v3/account", nil, &accounts.Data)
 	if err != nil {
-		return nil, hc.HandleError(fmt.Errorf("error getting accounts: %v", err))
+		return nil, hc.HandleError(fmt.Errorf("error getting accounts: %w", err))
 	}
 
 	f := hc.NewFilterable(d)
 	var arr []map[string]interface{}
 	for _, account := range accounts.Data {
 		var overrides hc.CustomVariableOverrideListResponse
-		err := client.GET(fmt.Sprintf("/v3/account/%d/custom-variable?count=999999", account.ID), &overrides)
+		err := hc.ListInto(ctx, client, fmt.Sprintf("/v3/account/%d/custom-variable", account.ID), nil, &overrides.Data.Items)
 		if err != nil {
-			return nil, hc.HandleError(fmt.Errorf("error getting account overrides: %v", err))
+			return nil, hc.HandleError(fmt.Errorf("error getting account overrides: %w", err))
 		}
 
 		for _, override := range overrides.Data.Items {
-			if override.Override.Value == nil {
+			if override.Override == nil || override.Override.Value == nil {
 				continue
 			}
 
 			cvResp := new(hc.CustomVariableResponse)
-			err := client.GET(fmt.Sprintf("/v3/custom-variable/%d", override.CustomVariableID), cvResp)
+			err := client.GETContext(ctx, fmt.Sprintf("/v3/custom-variable/%d", override.CustomVariableID), cvResp)
 			if err != nil {
-				return nil, hc.HandleError(fmt.Errorf("failed to get custom variable type: %v", err))
+				return nil, hc.HandleError(fmt.Errorf("failed to get custom variable type: %w", err))
 			}
 
 			cvValueStr, err := hc.PackCvValueIntoJSONStr(override.Override.Value, cvResp.Data.Type)
 			if err != nil {
-				return nil, hc.HandleError(fmt.Errorf("failed to process value: %v", err))
+				return nil, hc.HandleError(fmt.Errorf("failed to process value: %w", err))
 			}
 
 			data := map[string]interface{}{
@@ -266,7 +278,7 @@ func getAllAccountOverrides(d *schema.ResourceData, client *hc.Client) ([]map[st
 
 			match, err := f.Match(data)
 			if err != nil {
-				return nil, hc.HandleError(fmt.Errorf("unable to filter Custom Variables: %v", err))
+				return nil, hc.HandleError(fmt.Errorf("unable to filter Custom Variables: %w", err))
 			}
 			if !match {
 				continue
@@ -279,36 +291,36 @@ func getAllAccountOverrides(d *schema.ResourceData, client *hc.Client) ([]map[st
 	return arr, nil
 }
 
-func getAllAccountCacheOverrides(d *schema.ResourceData, client *hc.Client) ([]map[string]interface{}, diag.Diagnostics) {
+func getAllAccountCacheOverrides(ctx context.Context, d *schema.ResourceData, client *hc.Client) ([]map[string]interface{}, diag.Diagnostics) {
 	var accountCaches hc.AccountCacheListResponse
-	err := client.GET("/v3/account-cache", &accountCaches)
+	err := hc.ListInto(ctx, client, "/v3/account-cache", nil, &accountCaches.Data)
 	if err != nil {
-		return nil, hc.HandleError(fmt.Errorf("error getting account caches: %v", err))
+		return nil, hc.HandleError(fmt.Errorf("error getting account caches: %w", err))
 	}
 
 	f := hc.NewFilterable(d)
 	var arr []map[string]interface{}
 	for _, accountCache := range accountCaches.Data {
 		var overrides hc.CustomVariableOverrideListResponse
-		err := client.GET(fmt.Sprintf("/v3/account-cache/%d/custom-variable?count=999999", accountCache.ID), &overrides)
+		err := hc.ListInto(ctx, client, fmt.Sprintf("/v3/account-cache/%d/custom-variable", accountCache.ID), nil, &overrides.Data.Items)
 		if err != nil {
-			return nil, hc.HandleError(fmt.Errorf("error getting account cache overrides: %v", err))
+			return nil, hc.HandleError(fmt.Errorf("error getting account cache overrides: %w", err))
 		}
 
 		for _, override := range overrides.Data.Items {
-			if override.Override.Value == nil {
+			if override.Override == nil || override.Override.Value == nil {
 				continue
 			}
 
 			cvResp := new(hc.CustomVariableResponse)
-			err := client.GET(fmt.Sprintf("/v3/custom-variable/%d", override.CustomVariableID), cvResp)
+			err := client.GETContext(ctx, fmt.Sprintf("/v3/custom-variable/%d", override.CustomVariableID), cvResp)
 			if err != nil {
-				return nil, hc.HandleError(fmt.Errorf("failed to get custom variable type: %v", err))
+				return nil, hc.HandleError(fmt.Errorf("failed to get custom variable type: %w", err))
 			}
 
 			cvValueStr, err := hc.PackCvValueIntoJSONStr(override.Override.Value, cvResp.Data.Type)
 			if err != nil {
-				return nil, hc.HandleError(fmt.Errorf("failed to process value: %v", err))
+				return nil, hc.HandleError(fmt.Errorf("failed to process value: %w", err))
 			}
 
 			data := map[string]interface{}{
@@ -320,7 +332,7 @@ func getAllAccountCacheOverrides(d *schema.ResourceData, client *hc.Client) ([]m
 
 			match, err := f.Match(data)
 			if err != nil {
-				return nil, hc.HandleError(fmt.Errorf("unable to filter Custom Variables: %v", err))
+				return nil, hc.HandleError(fmt.Errorf("unable to filter Custom Variables: %w", err))
 			}
 			if !match {
 				continue
@@ -4,11 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
 )
 
@@ -35,7 +34,7 @@ func dataSourceCustomVariable() *schema.Resource {
 						"values": {
 							Description: "The values of the field name you specified.",
 							Type:        schema.TypeList,
-							Required:    true,
+							Optional:    true,
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
 						"regex": {
@@ -44,6 +43,19 @@ func dataSourceCustomVariable() *schema.Resource {
 							Optional:    true,
 							Default:     false,
 						},
+						"operator": {
+							Description:  "How the field is compared with the values: equals (default), not_equals, contains, prefix, in, gt, gte, lt and lte for numbers and dates, or exists and not_exists, which take no values.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      hc.FilterEquals,
+							ValidateFunc: validation.StringInSlice(hc.FilterOperators, false),
+						},
+						"case_insensitive": {
+							Description: "Dictates if the values should be compared regardless of case.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
 					},
 				},
 			},
@@ -117,9 +129,9 @@ func dataSourceCustomVariablesRead(ctx context.Context, d *schema.ResourceData,
 	client := m.(*hc.Client)
 
 	resp := new(hc.CustomVariableListResponse)
-	err := client.GET("/v3/custom-variable?count=999999", resp)
+	err := hc.ListInto(ctx, client, "/v3/custom-variable", nil, &resp.Data.Items)
 	if err != nil {
-		return hc.HandleError(fmt.Errorf("unable to read Custom Variables: %v", err))
+		return hc.HandleError(fmt.Errorf("unable to read Custom Variables: %w", err))
 	}
 
 	f := hc.NewFilterable(d)
@@ -128,7 +140,7 @@ func dataSourceCustomVariablesRead(ctx context.Context, d *schema.ResourceData,
 	for _, item := range resp.Data.Items {
 		cvValueStr, err := hc.PackCvValueIntoJSONStr(item.DefaultValue, item.Type)
 		if err != nil {
-			return hc.HandleError(fmt.Errorf("failed to process default_value: %v", err))
+			return hc.HandleError(fmt.Errorf("failed to process default_value: %w", err))
 		}
 
 		data := map[string]interface{}{
@@ -162,7 +174,7 @@ func dataSourceCustomVariablesRead(ctx context.Context, d *schema.ResourceData,
 
 		match, err := f.Match(data)
 		if err != nil {
-			return hc.HandleError(fmt.Errorf("unable to filter Custom Variables: %v", err))
+			return hc.HandleError(fmt.Errorf("unable to filter Custom Variables: %w", err))
 		} else if !match {
 			continue
 		}
@@ -171,7 +183,7 @@ func dataSourceCustomVariablesRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	diags = append(diags, hc.SafeSet(d, "list", arr, "Failed to set list")...)
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.SetId(hc.DataSourceID(d, "filter"))
 
 	return diags
 }
@@ -3,8 +3,6 @@ package kion
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -53,7 +51,7 @@ func dataSourceFundingSourcePermissionMappingRead(ctx context.Context, d *schema
 	fundingSourceID := d.Get("funding_source_id").(int)
 
 	resp := new(hc.FundingSourcePermissionsMappingListResponse)
-	err := client.GET(fmt.Sprintf("/v3/funding-source/%d/permission-mapping", fundingSourceID), resp)
+	err := hc.ListInto(ctx, client, fmt.Sprintf("/v3/funding-source/%d/permission-mapping", fundingSourceID), nil, &resp.Data)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -72,8 +70,7 @@ func dataSourceFundingSourcePermissionMappingRead(ctx context.Context, d *schema
 		return diag.FromErr(err)
 	}
 
-	// Set the ID of the datasource to a unique value, which is the current timestamp
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.SetId(hc.DataSourceID(d, "funding_source_id"))
 
 	return nil
 }
@@ -2,8 +2,6 @@ package kion
 
 import (
 	"context"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -45,7 +43,7 @@ func dataSourceGlobalPermissionMappingRead(ctx context.Context, d *schema.Resour
 	client := m.(*hc.Client)
 
 	resp := new(hc.ProjectPermissionMappingListResponse)
-	err := client.GET("/v3/global/permission-mapping", resp)
+	err := hc.ListInto(ctx, client, "/v3/global/permission-mapping", nil, &resp.Data)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -64,8 +62,7 @@ func dataSourceGlobalPermissionMappingRead(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	// Set the ID of the datasource to a unique value, which is the current timestamp
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.SetId(hc.DataSourceID(d))
 
 	return nil
 }
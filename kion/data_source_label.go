@@ -3,11 +3,10 @@ package kion
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
 )
 
@@ -28,7 +27,7 @@ func dataSourceLabel() *schema.Resource {
 						"values": {
 							Description: "The values of the field name you specified.",
 							Type:        schema.TypeList,
-							Required:    true,
+							Optional:    true,
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
 						"regex": {
@@ -37,6 +36,19 @@ func dataSourceLabel() *schema.Resource {
 							Optional:    true,
 							Default:     false,
 						},
+						"operator": {
+							Description:  "How the field is compared with the values: equals (default), not_equals, contains, prefix, in, gt, gte, lt and lte for numbers and dates, or exists and not_exists, which take no values.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      hc.FilterEquals,
+							ValidateFunc: validation.StringInSlice(hc.FilterOperators, false),
+						},
+						"case_insensitive": {
+							Description: "Dictates if the values should be compared regardless of case.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
 					},
 				},
 			},
@@ -73,39 +85,21 @@ func dataSourceLabelRead(ctx context.Context, d *schema.ResourceData, m interfac
 	var diags diag.Diagnostics
 	client := m.(*hc.Client)
 
-	// Fetch all labels across all pages. The API returns 100 labels per
-	// page by default, so we must paginate to get the complete set.
-	var allItems []hc.Label
-	page := 1
-	pageSize := 100
-	for {
-		resp := new(hc.LabelListResponse)
-		params := map[string]string{
-			"page":  strconv.Itoa(page),
-			"count": strconv.Itoa(pageSize),
-		}
-		err := client.GETWithParams("/v3/label", params, resp)
-		if err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "Unable to read Labels",
-				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), "all"),
-			})
-			return diags
-		}
-
-		allItems = append(allItems, resp.Data.Items...)
-
-		if len(allItems) >= resp.Data.Total || len(resp.Data.Items) == 0 {
-			break
-		}
-		page++
+	resp := new(hc.LabelListResponse)
+	err := hc.ListInto(ctx, client, "/v3/label", nil, &resp.Data.Items)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read Labels",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), "all"),
+		})
+		return diags
 	}
 
 	f := hc.NewFilterable(d)
 
 	arr := make([]map[string]interface{}, 0)
-	for _, item := range allItems {
+	for _, item := range resp.Data.Items {
 		data := make(map[string]interface{})
 
 		data["id"] = item.ID
@@ -137,8 +131,7 @@ func dataSourceLabelRead(ctx context.Context, d *schema.ResourceData, m interfac
 		return diags
 	}
 
-	// Always run.
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.SetId(hc.DataSourceID(d, "filter"))
 
 	return diags
 }
@@ -121,7 +121,7 @@ func dataSourceOUCloudAccessRoleRead(ctx context.Context, d *schema.ResourceData
 	ID := d.Get("id").(int)
 
 	resp := new(hc.OUCloudAccessRoleResponse)
-	err := client.GET(fmt.Sprintf("/v3/ou-cloud-access-role/%d", ID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/ou-cloud-access-role/%d", ID), resp)
 	if err != nil {
 		return append(diags, diag.Diagnostic{
 			Severity: diag.Error,
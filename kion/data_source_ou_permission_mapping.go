@@ -3,8 +3,6 @@ package kion
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -53,7 +51,7 @@ func dataSourceOUPermissionMappingRead(ctx context.Context, d *schema.ResourceDa
 	ouID := d.Get("ou_id").(int)
 
 	resp := new(hc.OUPermissionMappingListResponse)
-	err := client.GET(fmt.Sprintf("/v3/ou/%d/permission-mapping", ouID), resp)
+	err := hc.ListInto(ctx, client, fmt.Sprintf("/v3/ou/%d/permission-mapping", ouID), nil, &resp.Data)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -72,8 +70,7 @@ func dataSourceOUPermissionMappingRead(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	// Set the ID of the datasource to a unique value, which is the current timestamp
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.SetId(hc.DataSourceID(d, "ou_id"))
 
 	return nil
 }
@@ -166,7 +166,7 @@ func dataSourceProjectCloudAccessRoleRead(ctx context.Context, d *schema.Resourc
 
 	// Fetch the specific project cloud access role by ID using the same endpoint as the resource
 	resp := new(hc.ProjectCloudAccessRoleResponse)
-	err := client.GET(fmt.Sprintf("/v3/project-cloud-access-role/%s", projectCloudAccessRoleID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/project-cloud-access-role/%s", projectCloudAccessRoleID), resp)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to read project cloud access role by ID: %v", err))
 	}
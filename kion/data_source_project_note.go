@@ -3,7 +3,6 @@ package kion
 import (
 	"context"
 	"fmt"
-	"net/url"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -59,10 +58,11 @@ func dataSourceProjectNoteRead(ctx context.Context, d *schema.ResourceData, m in
 
 	// Get project notes with project_id filter
 	resp := new(hc.ProjectNoteListResponse)
-	params := url.Values{}
-	params.Add("project_id", strconv.Itoa(d.Get("project_id").(int)))
+	params := map[string]string{
+		"project_id": strconv.Itoa(d.Get("project_id").(int)),
+	}
 
-	err := client.GET(fmt.Sprintf("/v3/project-note?%s", params.Encode()), resp)
+	err := hc.ListInto(ctx, client, "/v3/project-note", params, &resp.Data)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to read Project Note: %v", err))
 	}
@@ -0,0 +1,87 @@
+package kion
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
+)
+
+// dataSourceTemporaryCredentials is the fallback of the
+// kion_temporary_credentials ephemeral resource for Terraform versions without
+// ephemeral resources. Its credentials are stored in the state.
+func dataSourceTemporaryCredentials() *schema.Resource {
+	return &schema.Resource{
+		Description: "Temporary AWS credentials of a cloud access role, for configuring other providers. " +
+			"Only AWS accounts are supported; Azure and GCP accounts are not. " +
+			"The credentials are stored in the state; with Terraform 1.10 or later use the kion_temporary_credentials ephemeral resource instead.",
+		ReadContext: dataSourceTemporaryCredentialsRead,
+		Schema: map[string]*schema.Schema{
+			"account_number": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The number of the AWS account.",
+			},
+			"aws_iam_role_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The IAM role of the cloud access role, e.g. aws_iam_role_name of a kion_project_cloud_access_role.",
+			},
+			"access_key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The AWS access key ID.",
+			},
+			"secret_access_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The AWS secret access key.",
+			},
+			"session_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The AWS session token.",
+			},
+			"expiration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the credentials expire.",
+			},
+		},
+	}
+}
+
+func dataSourceTemporaryCredentialsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*hc.Client)
+	accountNumber := d.Get("account_number").(string)
+	roleName := d.Get("aws_iam_role_name").(string)
+
+	creds, err := hc.GetTemporaryCredentials(ctx, client, accountNumber, roleName)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to get temporary credentials",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), accountNumber+"/"+roleName),
+		})
+		return diags
+	}
+
+	data := map[string]interface{}{
+		"access_key_id":     creds.AccessKeyID,
+		"secret_access_key": creds.SecretAccessKey,
+		"session_token":     creds.SessionToken,
+		"expiration":        creds.Expiration,
+	}
+	for k, v := range data {
+		diags = append(diags, hc.SafeSet(d, k, v, "Unable to set temporary credentials")...)
+	}
+
+	d.SetId(accountNumber + "/" + roleName)
+
+	return diags
+}
@@ -2,11 +2,10 @@ package kion
 
 import (
 	"context"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
 )
 
@@ -48,6 +47,19 @@ func dataSourceUser() *schema.Resource {
 							Optional:    true,
 							Default:     false,
 						},
+						"operator": {
+							Description:  "How the field is compared with the values: equals (default), not_equals, contains, prefix, in, gt, gte, lt and lte for numbers and dates, or exists and not_exists, which take no values.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      hc.FilterEquals,
+							ValidateFunc: validation.StringInSlice(hc.FilterOperators, false),
+						},
+						"case_insensitive": {
+							Description: "Dictates if the values should be compared regardless of case.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
 					},
 				},
 			},
@@ -67,7 +79,7 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface
 	client := m.(*hc.Client)
 
 	resp := new(hc.UserListResponse) // Use the UserListResponse struct from models_user.go
-	err := client.GET("/v3/user", resp)
+	err := hc.ListInto(ctx, client, "/v3/user", nil, &resp.Data)
 	if diags := hc.HandleError(err); diags != nil {
 		return diags
 	}
@@ -78,9 +90,13 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface
 	var userIDs []int
 	for _, item := range resp.Data {
 		data := map[string]interface{}{
-			"id":       item.ID,
-			"username": item.Username,
-			"enabled":  item.Enabled,
+			"id":         item.ID,
+			"username":   item.Username,
+			"enabled":    item.Enabled,
+			"email":      item.Email,
+			"first_name": item.FirstName,
+			"last_name":  item.LastName,
+			"idms_id":    item.IdmsID,
 		}
 
 		match, err := f.Match(data)
@@ -95,8 +111,7 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface
 
 	diags := hc.SafeSet(d, "list", userIDs, "list of user IDs")
 
-	// Set a unique ID for this data source instance
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.SetId(hc.DataSourceID(d, "filter"))
 
 	return diags
 }
@@ -42,6 +42,7 @@ func dataSourceWebhook() *schema.Resource {
 			"request_headers": {
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "Headers to be included in the webhook request.",
 			},
 			"request_method": {
@@ -95,7 +96,7 @@ func dataSourceWebhookRead(ctx context.Context, d *schema.ResourceData, m interf
 
 	// Fetch the specific webhook by ID
 	resp := new(hc.WebhookWithOwnersResponse)
-	err := client.GET(fmt.Sprintf("/v3/webhook/%s", webhookID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/webhook/%s", webhookID), resp)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to read webhook by ID: %v", err))
 	}
@@ -0,0 +1,115 @@
+package kion
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
+)
+
+// temporaryCredentialsEphemeralResource fetches temporary AWS credentials of
+// a cloud access role. Being ephemeral, the credentials are never written to
+// the plan or state.
+type temporaryCredentialsEphemeralResource struct {
+	client *hc.Client
+}
+
+type temporaryCredentialsModel struct {
+	AccountNumber   types.String `tfsdk:"account_number"`
+	AwsIamRoleName  types.String `tfsdk:"aws_iam_role_name"`
+	AccessKeyID     types.String `tfsdk:"access_key_id"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	SessionToken    types.String `tfsdk:"session_token"`
+	Expiration      types.String `tfsdk:"expiration"`
+}
+
+func newTemporaryCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &temporaryCredentialsEphemeralResource{}
+}
+
+func (r *temporaryCredentialsEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_temporary_credentials"
+}
+
+func (r *temporaryCredentialsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Temporary AWS credentials of a cloud access role, for configuring other providers. " +
+			"Only AWS accounts are supported; Azure and GCP accounts are not. " +
+			"The credentials are never stored in the plan or state. Use the kion_temporary_credentials data source with Terraform versions before 1.10.",
+		Attributes: map[string]schema.Attribute{
+			"account_number": schema.StringAttribute{
+				Required:    true,
+				Description: "The number of the AWS account.",
+			},
+			"aws_iam_role_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The IAM role of the cloud access role, e.g. aws_iam_role_name of a kion_project_cloud_access_role.",
+			},
+			"access_key_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The AWS access key ID.",
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The AWS secret access key.",
+			},
+			"session_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The AWS session token.",
+			},
+			"expiration": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the credentials expire.",
+			},
+		},
+	}
+}
+
+func (r *temporaryCredentialsEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*hc.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("Expected *kionclient.Client, got %T. This is a bug in the provider.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *temporaryCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	// Terraform may open ephemeral resources before the provider is
+	// configured, e.g. when its configuration is unknown during planning.
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client",
+			"The provider has not been configured yet, so temporary credentials cannot be requested. "+
+				"Make sure the provider configuration does not depend on values that are only known after apply.")
+		return
+	}
+
+	var data temporaryCredentialsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountNumber := data.AccountNumber.ValueString()
+	roleName := data.AwsIamRoleName.ValueString()
+	creds, err := hc.GetTemporaryCredentials(ctx, r.client, accountNumber, roleName)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get temporary credentials",
+			fmt.Sprintf("Error: %v\nItem: %v", err.Error(), accountNumber+"/"+roleName))
+		return
+	}
+
+	data.AccessKeyID = types.StringValue(creds.AccessKeyID)
+	data.SecretAccessKey = types.StringValue(creds.SecretAccessKey)
+	data.SessionToken = types.StringValue(creds.SessionToken)
+	data.Expiration = types.StringValue(creds.Expiration)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
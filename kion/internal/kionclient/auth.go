@@ -0,0 +1,173 @@
+package kionclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrTokenUnavailable is returned when the client's TokenSource could not
+// supply a token, e.g. because the API key file is missing or the login failed.
+var ErrTokenUnavailable = errors.New("kion: unable to obtain token")
+
+// tokenExpiryMargin is how long before its expiry a cached token is renewed,
+// so a request never goes out with a token that expires in flight.
+const tokenExpiryMargin = time.Minute
+
+// TokenSource supplies the bearer token sent with every request. It is used
+// instead of Client.Token when set.
+type TokenSource interface {
+	// Token returns a valid token, obtaining a new one when none is cached or
+	// the cached one is about to expire.
+	Token(ctx context.Context) (string, error)
+	// Invalidate discards token after Kion rejected it, so the next call to
+	// Token obtains a new one.
+	Invalidate(token string)
+}
+
+// cachedTokenSource caches the token returned by fetch until it expires or is
+// invalidated. A zero expiry means the token is used until Kion rejects it.
+type cachedTokenSource struct {
+	fetch func(ctx context.Context) (string, time.Time, error)
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+func (s *cachedTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && (s.expiry.IsZero() || time.Until(s.expiry) > tokenExpiryMargin) {
+		return s.token, nil
+	}
+
+	token, expiry, err := s.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+	if token == "" {
+		return "", errors.New("the token is empty")
+	}
+	s.token, s.expiry = token, expiry
+	return token, nil
+}
+
+func (s *cachedTokenSource) Invalidate(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Another request may already have replaced the rejected token.
+	if s.token == token {
+		s.token = ""
+	}
+}
+
+// FileTokenSource reads the API key from the file at path. The file is read
+// again whenever Kion rejects the key, so it can be rotated during an apply.
+func FileTokenSource(path string) TokenSource {
+	return &cachedTokenSource{
+		fetch: func(ctx context.Context) (string, time.Time, error) {
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return "", time.Time{}, err
+			}
+			return strings.TrimSpace(string(content)), time.Time{}, nil
+		},
+	}
+}
+
+// CommandTokenSource runs command through the system shell and uses its
+// standard output as the API key. The command is run again whenever Kion
+// rejects the key, so it may hand out short-lived credentials.
+func CommandTokenSource(command string) TokenSource {
+	return &cachedTokenSource{
+		fetch: func(ctx context.Context) (string, time.Time, error) {
+			var cmd *exec.Cmd
+			if runtime.GOOS == "windows" {
+				cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+			} else {
+				cmd = exec.CommandContext(ctx, "sh", "-c", command)
+			}
+
+			var stderr bytes.Buffer
+			cmd.Stderr = &stderr
+			out, err := cmd.Output()
+			if err != nil {
+				return "", time.Time{}, fmt.Errorf("command failed: %v: %s", err, strings.TrimSpace(stderr.String()))
+			}
+			return strings.TrimSpace(string(out)), time.Time{}, nil
+		},
+	}
+}
+
+// SessionTokenSource logs in to Kion with a username and password against the
+// identity management system idmsID (1 is the local IDMS) and uses the
+// returned session token. A new session is started shortly before the current
+// one expires or when Kion rejects it.
+func (client *Client) SessionTokenSource(idmsID int, username, password string) TokenSource {
+	return &cachedTokenSource{
+		fetch: func(ctx context.Context) (string, time.Time, error) {
+			rb, err := json.Marshal(LoginRequest{
+				IDMS:     idmsID,
+				Username: username,
+				Password: password,
+			})
+			if err != nil {
+				return "", time.Time{}, err
+			}
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, client.HostURL+"/v3/login", bytes.NewReader(rb))
+			if err != nil {
+				return "", time.Time{}, err
+			}
+
+			// Logging in has no side effects, so it is safe to retry.
+			retry := true
+			body, _, err := client.doRequestWithRetry(req, &requestOptions{retry: &retry, noAuth: true})
+			if err != nil {
+				return "", time.Time{}, fmt.Errorf("login as %q failed: %w", username, err)
+			}
+
+			resp := new(LoginResponse)
+			if err := json.Unmarshal(body, resp); err != nil {
+				return "", time.Time{}, fmt.Errorf("could not unmarshal login response: %v", err)
+			}
+
+			// An unparsable expiry is treated as unknown: the token is then
+			// renewed once Kion rejects it.
+			expiry, _ := time.Parse(time.RFC3339, resp.Data.Access.Expiry)
+			return resp.Data.Access.Token, expiry, nil
+		},
+	}
+}
+
+// authorize sets the Authorization header of req. It returns the token that
+// was used, or an empty string if the request is sent without one.
+func (client *Client) authorize(req *http.Request, o *requestOptions) (string, error) {
+	if o.noAuth {
+		return "", nil
+	}
+
+	token := client.Token
+	if client.TokenSource != nil {
+		var err error
+		token, err = client.TokenSource.Token(req.Context())
+		if err != nil {
+			return "", NewRequestError(0, fmt.Errorf("%w: %w", ErrTokenUnavailable, err))
+		}
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	return token, nil
+}
@@ -0,0 +1,107 @@
+package kionclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionTokenSource(t *testing.T) {
+	var logins int32
+	var current, lastBody atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v3/login" {
+			var login LoginRequest
+			_ = json.NewDecoder(r.Body).Decode(&login)
+			if login.Username != "ci" || login.Password != "secret" || login.IDMS != 2 {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			token := fmt.Sprintf("session-%d", atomic.AddInt32(&logins, 1))
+			current.Store(token)
+			expiry := time.Now().Add(time.Hour).Format(time.RFC3339)
+			_, _ = fmt.Fprintf(w, `{"data":{"access":{"token":%q,"expiry":%q}},"status":200}`, token, expiry)
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer "+current.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		lastBody.Store(string(body))
+		_, _ = w.Write([]byte(`{"record_id":1,"status":201}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.TokenSource = client.SessionTokenSource(2, "ci", "secret")
+
+	assert.NoError(t, client.GET("/v3/ou", nil))
+	assert.NoError(t, client.GET("/v3/ou", nil))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&logins))
+
+	// Simulate the session ending on the server: the next request is
+	// rejected once, a new session is started and the request is resent
+	// with its body, even for a non-idempotent method.
+	current.Store("expired")
+	_, err := client.POST("/v3/ou", map[string]string{"name": "test"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&logins))
+	assert.Equal(t, `{"name":"test"}`, lastBody.Load())
+
+	client.TokenSource = client.SessionTokenSource(2, "ci", "wrong")
+	err = client.GET("/v3/ou", nil)
+	assert.ErrorIs(t, err, ErrTokenUnavailable)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+}
+
+func TestFileTokenSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "apikey")
+	assert.NoError(t, os.WriteFile(path, []byte("app_1_first\n"), 0o600))
+
+	source := FileTokenSource(path)
+	token, err := source.Token(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "app_1_first", token)
+
+	// The file is only read again once the key was rejected.
+	assert.NoError(t, os.WriteFile(path, []byte("app_1_second"), 0o600))
+	token, _ = source.Token(context.Background())
+	assert.Equal(t, "app_1_first", token)
+
+	source.Invalidate("app_1_first")
+	token, _ = source.Token(context.Background())
+	assert.Equal(t, "app_1_second", token)
+
+	_, err = FileTokenSource(filepath.Join(t.TempDir(), "missing")).Token(context.Background())
+	assert.Error(t, err)
+}
+
+func TestCommandTokenSource(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell")
+	}
+
+	token, err := CommandTokenSource("echo app_1_from_command").Token(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "app_1_from_command", token)
+
+	_, err = CommandTokenSource("echo broken >&2; exit 1").Token(context.Background())
+	assert.ErrorContains(t, err, "broken")
+
+	_, err = CommandTokenSource("true").Token(context.Background())
+	assert.Error(t, err)
+}
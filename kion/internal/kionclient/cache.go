@@ -0,0 +1,152 @@
+package kionclient
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/sync/singleflight"
+)
+
+// versionSegment matches the API version in a request path, e.g. "v3".
+var versionSegment = regexp.MustCompile(`^v\d+$`)
+
+// responseCache keeps the bodies of successful GET responses for the lifetime
+// of the client, which is a single Terraform command. Concurrent identical
+// GETs are collapsed into one request.
+type responseCache struct {
+	group singleflight.Group
+
+	mu      sync.Mutex
+	entries map[string][]byte
+	// generation is bumped by every invalidation, so a GET that was in flight
+	// while a mutation happened does not store a stale body.
+	generation uint64
+}
+
+// EnableCache turns on the in-memory GET response cache. Any request that is
+// not a GET invalidates the cached responses of the collection it targets,
+// e.g. a PATCH of /v3/ou/5 drops /v3/ou, /v3/ou/5 and /v3/ou/5/labels. Use
+// WithoutCache for requests that must always reach Kion, such as polling.
+func (client *Client) EnableCache() {
+	client.cache = &responseCache{entries: make(map[string][]byte)}
+}
+
+// WithoutCache bypasses the response cache for a single GET. The fresh
+// response still replaces the cached one.
+func WithoutCache() RequestOption {
+	return func(o *requestOptions) {
+		o.noCache = true
+	}
+}
+
+// send performs the request through the response cache when it is enabled.
+func (client *Client) send(req *http.Request, o *requestOptions) ([]byte, int, error) {
+	c := client.cache
+	if c == nil {
+		return client.doRequestWithRetry(req, o)
+	}
+
+	if req.Method != http.MethodGet {
+		// Invalidate even when the request failed: Kion may have applied
+		// part of it.
+		defer c.invalidate(collectionPrefix(req.URL.Path))
+		return client.doRequestWithRetry(req, o)
+	}
+
+	key := req.URL.RequestURI()
+	if !o.noCache {
+		if body, ok := c.get(key); ok {
+			tflog.SubsystemDebug(httpLogContext(req.Context()), HTTPLogSubsystem, "Kion API response served from cache", map[string]interface{}{
+				"method": req.Method,
+				"url":    req.URL.String(),
+			})
+			return body, http.StatusOK, nil
+		}
+	}
+
+	fetch := func() (interface{}, error) {
+		generation := c.currentGeneration()
+		body, _, err := client.doRequestWithRetry(req, o)
+		if err != nil {
+			return nil, err
+		}
+		c.put(key, body, generation)
+		return body, nil
+	}
+
+	var (
+		v   interface{}
+		err error
+	)
+	if o.noCache {
+		v, err = fetch()
+	} else {
+		// The result of the first caller is shared, including an error
+		// caused by its context being cancelled.
+		v, err, _ = c.group.Do(key, fetch)
+	}
+	if err != nil {
+		var statusCode int
+		var reqErr *RequestError
+		if errors.As(err, &reqErr) {
+			statusCode = reqErr.StatusCode
+		}
+		return nil, statusCode, err
+	}
+	return v.([]byte), http.StatusOK, nil
+}
+
+func (c *responseCache) get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	body, ok := c.entries[key]
+	return body, ok
+}
+
+func (c *responseCache) put(key string, body []byte, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation == c.generation {
+		c.entries[key] = body
+	}
+}
+
+func (c *responseCache) currentGeneration() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.generation
+}
+
+// invalidate drops every cached response whose path starts with prefix.
+func (c *responseCache) invalidate(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	for key := range c.entries {
+		rest, ok := strings.CutPrefix(key, prefix)
+		if ok && (rest == "" || rest[0] == '/' || rest[0] == '?') {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// collectionPrefix returns the path up to the collection a request targets,
+// e.g. "/api/v3/ou" for "/api/v3/ou/5/labels". Paths without a version
+// segment are returned unchanged.
+func collectionPrefix(urlPath string) string {
+	segments := strings.Split(urlPath, "/")
+	for i, segment := range segments {
+		if versionSegment.MatchString(segment) && i+1 < len(segments) {
+			return strings.Join(segments[:i+2], "/")
+		}
+	}
+	return urlPath
+}
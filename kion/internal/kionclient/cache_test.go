@@ -0,0 +1,103 @@
+package kionclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseCache(t *testing.T) {
+	var gets int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			atomic.AddInt32(&gets, 1)
+		}
+		_, _ = w.Write([]byte(`{"data":{"id":5,"name":"ou"},"status":200}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.EnableCache()
+
+	var resp struct {
+		Data struct {
+			Name string `json:"name"`
+		} `json:"data"`
+	}
+	assert.NoError(t, client.GET("/v3/ou/5", &resp))
+	assert.NoError(t, client.GET("/v3/ou/5", &resp))
+	assert.Equal(t, "ou", resp.Data.Name)
+	assert.NoError(t, client.GET("/v3/ou-cloud-access-role/5", nil))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&gets))
+
+	// WithoutCache always reaches Kion.
+	assert.NoError(t, client.GET("/v3/ou/5", nil, WithoutCache()))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&gets))
+
+	// A mutation of the collection drops its entries but not those of
+	// collections that merely share the prefix.
+	assert.NoError(t, client.PATCH("/v3/ou/7", map[string]string{"name": "other"}))
+	assert.NoError(t, client.GET("/v3/ou/5", nil))
+	assert.NoError(t, client.GET("/v3/ou-cloud-access-role/5", nil))
+	assert.Equal(t, int32(4), atomic.LoadInt32(&gets))
+}
+
+func TestResponseCacheSingleflight(t *testing.T) {
+	var gets int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&gets, 1)
+		<-release
+		_, _ = w.Write([]byte(`{"data":[],"status":200}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.EnableCache()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, client.GET("/v3/project", nil))
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&gets))
+}
+
+func TestResponseCacheSkipsErrors(t *testing.T) {
+	var gets int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&gets, 1) == 1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":200}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.EnableCache()
+
+	err := client.GET("/v3/project/1", nil)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NoError(t, client.GET("/v3/project/1", nil))
+	assert.NoError(t, client.GET("/v3/project/1", nil))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&gets))
+}
+
+func TestCollectionPrefix(t *testing.T) {
+	assert.Equal(t, "/api/v3/ou", collectionPrefix("/api/v3/ou/5/labels"))
+	assert.Equal(t, "/v4/iam-policy", collectionPrefix("/v4/iam-policy"))
+	assert.Equal(t, "/other", collectionPrefix("/other"))
+}
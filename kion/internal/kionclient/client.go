@@ -3,84 +3,141 @@ package kionclient
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"net/url"
 	"path"
 	"reflect"
 	"strings"
+	"sync"
+	"time"
 )
 
-type RequestError struct {
-	StatusCode int
-	Err        error
-}
-
-func (r RequestError) Error() string {
-	return r.Err.Error()
-}
-
-func NewRequestError(statusCode int, err error) error {
-	return &RequestError{StatusCode: statusCode, Err: err}
-}
-
 // Client represents a client to interact with the Kion application.
 type Client struct {
 	HostURL    string
 	HTTPClient *http.Client
 	Token      string
+
+	// TokenSource, when set, supplies the bearer token instead of Token.
+	TokenSource TokenSource
+
+	// MaxRetries is how many times a transient failure is retried. Only
+	// idempotent methods are retried unless a request opts in with WithRetry.
+	MaxRetries int
+	// RetryWaitMin is the backoff before the first retry. It doubles with
+	// every further attempt.
+	RetryWaitMin time.Duration
+	// RetryMaxWait caps the backoff between attempts, including any wait
+	// requested by the server through Retry-After.
+	RetryMaxWait time.Duration
+
+	// DefaultLabels are merged into the labels of every resource that
+	// supports them. Labels set on a resource win.
+	DefaultLabels map[string]string
+	// CreateMissingLabels makes PutAppLabelIDs create labels that do not
+	// exist yet, with the color MissingLabelColor.
+	CreateMissingLabels bool
+	MissingLabelColor   string
+	// DeleteUnusedLabels makes label changes delete labels with the color
+	// MissingLabelColor that no object uses any more.
+	DeleteUnusedLabels bool
+	// labelsMu serializes the creation and deletion of labels.
+	labelsMu sync.Mutex
+	// objectLabelsMu holds a *sync.Mutex per object whose labels are
+	// changed with a read-modify-write.
+	objectLabelsMu sync.Map
+
+	// throttle enforces the limits set with SetRateLimit and
+	// SetMaxConcurrentRequests.
+	throttle requestLimiter
+	// cache holds GET responses once EnableCache was called.
+	cache *responseCache
 }
 
-// NewClient creates a new Client instance.
-func NewClient(kionURL, kionAPIKey, kionAPIPath string, skipSSLValidation bool) *Client {
+// NewClient creates a new Client instance. It returns an error wrapping
+// ErrInvalidURL when kionURL is not an absolute http or https URL, or the
+// error of the first ClientOption that could not be applied.
+func NewClient(kionURL, kionAPIKey, kionAPIPath string, skipSSLValidation bool, opts ...ClientOption) (*Client, error) {
+	u, err := url.Parse(kionURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %v", ErrInvalidURL, kionURL, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%w %q: it must be an absolute http or https URL such as https://kion.example.com", ErrInvalidURL, kionURL)
+	}
+	u.Path = path.Join(strings.TrimRight(u.Path, "/"), strings.TrimRight(kionAPIPath, "/"))
+
 	customTransport := http.DefaultTransport.(*http.Transport).Clone()
 	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: skipSSLValidation}
+	for _, opt := range opts {
+		if err := opt(customTransport); err != nil {
+			return nil, err
+		}
+	}
 
 	client := &Client{
+		HostURL: u.String(),
 		HTTPClient: &http.Client{
 			Transport: customTransport,
 		},
-		Token: kionAPIKey,
+		Token:        kionAPIKey,
+		MaxRetries:   DefaultMaxRetries,
+		RetryWaitMin: DefaultRetryWaitMin,
+		RetryMaxWait: DefaultRetryMaxWait,
 	}
 
-	u, err := url.Parse(kionURL)
+	return client, nil
+}
+
+// doRequest performs a single attempt of the request. Besides the body and
+// status code it returns the wait requested by the server through Retry-After.
+// The attempt first waits for the client's rate and concurrency limits and is
+// logged to the kion_http subsystem.
+func (client *Client) doRequest(req *http.Request) ([]byte, int, time.Duration, error) {
+	release, err := client.throttle.acquire(req.Context())
 	if err != nil {
-		log.Fatalf("The URL is not valid: %s, %v", kionURL, err)
+		return nil, 0, 0, NewRequestError(0, err)
 	}
-	u.Path = path.Join(strings.TrimRight(u.Path, "/"), strings.TrimRight(kionAPIPath, "/"))
-	client.HostURL = u.String()
+	defer release()
 
-	return client
-}
-
-func (client *Client) doRequest(req *http.Request) ([]byte, int, error) {
-	req.Header.Set("Authorization", "Bearer "+client.Token)
+	logCtx := httpLogContext(req.Context())
+	start := time.Now()
 
 	res, err := client.HTTPClient.Do(req)
 	if err != nil {
-		return nil, 0, NewRequestError(0, err)
+		logResponse(logCtx, req, nil, nil, time.Since(start), err)
+		return nil, 0, 0, NewRequestError(0, err)
 	}
 	defer res.Body.Close()
 
+	retryAfter := parseRetryAfter(res.Header.Get("Retry-After"))
+
 	body, err := io.ReadAll(res.Body)
+	logResponse(logCtx, req, res, body, time.Since(start), err)
 	if err != nil {
-		return nil, res.StatusCode, NewRequestError(res.StatusCode, err)
+		return nil, res.StatusCode, retryAfter, NewRequestError(res.StatusCode, err)
 	}
 
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		return nil, res.StatusCode, NewRequestError(res.StatusCode, fmt.Errorf("url: %s, method: %s, status: %d, body: %s", req.URL.String(), req.Method, res.StatusCode, body))
+		return nil, res.StatusCode, retryAfter, newResponseError(req, res.StatusCode, body)
 	}
 
-	return body, res.StatusCode, nil
+	return body, res.StatusCode, retryAfter, nil
 }
 
 // GET retrieves an element from Kion.
-func (client *Client) GET(urlPath string, returnData interface{}) error {
+func (client *Client) GET(urlPath string, returnData interface{}, opts ...RequestOption) error {
+	return client.GETContext(context.Background(), urlPath, returnData, opts...)
+}
+
+// GETContext retrieves an element from Kion. The request is abandoned when ctx is done.
+func (client *Client) GETContext(ctx context.Context, urlPath string, returnData interface{}, opts ...RequestOption) error {
 	if returnData != nil {
 		v := reflect.ValueOf(returnData)
 		if v.Kind() != reflect.Ptr {
@@ -88,19 +145,19 @@ func (client *Client) GET(urlPath string, returnData interface{}) error {
 		}
 	}
 
-	req, err := http.NewRequest(http.MethodGet, client.HostURL+urlPath, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, client.HostURL+urlPath, nil)
 	if err != nil {
 		return err
 	}
 
-	body, statusCode, err := client.doRequest(req)
+	body, statusCode, err := client.send(req, newRequestOptions(opts))
 	if err != nil {
 		return err
 	}
 
 	if returnData != nil {
 		if err := json.Unmarshal(body, returnData); err != nil {
-			return NewRequestError(statusCode, fmt.Errorf("could not unmarshal response body: %v", string(body)))
+			return NewRequestError(statusCode, fmt.Errorf("could not unmarshal response body: %v", redactBody(body)))
 		}
 	}
 
@@ -108,63 +165,100 @@ func (client *Client) GET(urlPath string, returnData interface{}) error {
 }
 
 // POST creates an element in Kion.
-func (client *Client) POST(urlPath string, sendData interface{}) (*Creation, error) {
+func (client *Client) POST(urlPath string, sendData interface{}, opts ...RequestOption) (*Creation, error) {
+	return client.POSTContext(context.Background(), urlPath, sendData, opts...)
+}
+
+// POSTContext creates an element in Kion. The request is abandoned when ctx is done.
+func (client *Client) POSTContext(ctx context.Context, urlPath string, sendData interface{}, opts ...RequestOption) (*Creation, error) {
+	var data Creation
+	if err := client.PostWithResponseContext(ctx, urlPath, sendData, &data, opts...); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// PostWithResponseContext creates an element in Kion and decodes the response
+// into returnData, for endpoints that return more than the record ID. The
+// request is abandoned when ctx is done.
+func (client *Client) PostWithResponseContext(ctx context.Context, urlPath string, sendData, returnData interface{}, opts ...RequestOption) error {
 	rb, err := json.Marshal(sendData)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, client.HostURL+urlPath, bytes.NewBuffer(rb))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, client.HostURL+urlPath, bytes.NewBuffer(rb))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	body, _, err := client.doRequest(req)
+	body, _, err := client.send(req, newRequestOptions(opts))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	var data Creation
-	if err := json.Unmarshal(body, &data); err != nil {
-		return nil, fmt.Errorf("could not unmarshal response body: %v", string(body))
+	if err := json.Unmarshal(body, returnData); err != nil {
+		return fmt.Errorf("could not unmarshal response body: %v", redactBody(body))
 	}
 
-	return &data, nil
+	return nil
 }
 
 // PATCH updates an element in Kion.
-func (client *Client) PATCH(urlPath string, sendData interface{}) error {
-	return client.doPutPatch(http.MethodPatch, urlPath, sendData)
+func (client *Client) PATCH(urlPath string, sendData interface{}, opts ...RequestOption) error {
+	return client.PATCHContext(context.Background(), urlPath, sendData, opts...)
+}
+
+// PATCHContext updates an element in Kion. The request is abandoned when ctx is done.
+func (client *Client) PATCHContext(ctx context.Context, urlPath string, sendData interface{}, opts ...RequestOption) error {
+	return client.doPutPatch(ctx, http.MethodPatch, urlPath, sendData, opts...)
 }
 
 // PUT updates an element in Kion.
-func (client *Client) PUT(urlPath string, sendData interface{}) error {
-	return client.doPutPatch(http.MethodPut, urlPath, sendData)
+func (client *Client) PUT(urlPath string, sendData interface{}, opts ...RequestOption) error {
+	return client.PUTContext(context.Background(), urlPath, sendData, opts...)
+}
+
+// PUTContext updates an element in Kion. The request is abandoned when ctx is done.
+func (client *Client) PUTContext(ctx context.Context, urlPath string, sendData interface{}, opts ...RequestOption) error {
+	return client.doPutPatch(ctx, http.MethodPut, urlPath, sendData, opts...)
 }
 
 // doPutPatch is a helper for PUT and PATCH methods.
-func (client *Client) doPutPatch(method, urlPath string, sendData interface{}) error {
+func (client *Client) doPutPatch(ctx context.Context, method, urlPath string, sendData interface{}, opts ...RequestOption) error {
 	rb, err := json.Marshal(sendData)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest(method, client.HostURL+urlPath, bytes.NewBuffer(rb))
+	req, err := http.NewRequestWithContext(ctx, method, client.HostURL+urlPath, bytes.NewBuffer(rb))
 	if err != nil {
 		return err
 	}
 
-	_, _, err = client.doRequest(req)
+	_, _, err = client.send(req, newRequestOptions(opts))
 	return err
 }
 
 // DELETE removes an element from Kion. sendData can be nil.
-func (client *Client) DELETE(urlPath string, sendData interface{}) error {
-	return client.DeleteWithResponse(urlPath, sendData, nil)
+func (client *Client) DELETE(urlPath string, sendData interface{}, opts ...RequestOption) error {
+	return client.DELETEContext(context.Background(), urlPath, sendData, opts...)
+}
+
+// DELETEContext removes an element from Kion. sendData can be nil. The request
+// is abandoned when ctx is done.
+func (client *Client) DELETEContext(ctx context.Context, urlPath string, sendData interface{}, opts ...RequestOption) error {
+	return client.DeleteWithResponseContext(ctx, urlPath, sendData, nil, opts...)
 }
 
 // DeleteWithResponse deletes an element from Kion and returns a response.
-func (client *Client) DeleteWithResponse(urlPath string, sendData, returnData interface{}) error {
+func (client *Client) DeleteWithResponse(urlPath string, sendData, returnData interface{}, opts ...RequestOption) error {
+	return client.DeleteWithResponseContext(context.Background(), urlPath, sendData, returnData, opts...)
+}
+
+// DeleteWithResponseContext deletes an element from Kion and returns a response.
+// The request is abandoned when ctx is done.
+func (client *Client) DeleteWithResponseContext(ctx context.Context, urlPath string, sendData, returnData interface{}, opts ...RequestOption) error {
 	var req *http.Request
 	var err error
 
@@ -173,25 +267,25 @@ func (client *Client) DeleteWithResponse(urlPath string, sendData, returnData in
 		if err != nil {
 			return err
 		}
-		req, err = http.NewRequest(http.MethodDelete, client.HostURL+urlPath, bytes.NewBuffer(rb))
+		req, err = http.NewRequestWithContext(ctx, http.MethodDelete, client.HostURL+urlPath, bytes.NewBuffer(rb))
 		if err != nil {
 			return err
 		}
 	} else {
-		req, err = http.NewRequest(http.MethodDelete, client.HostURL+urlPath, nil)
+		req, err = http.NewRequestWithContext(ctx, http.MethodDelete, client.HostURL+urlPath, nil)
 		if err != nil {
 			return err
 		}
 	}
 
-	body, statusCode, err := client.doRequest(req)
+	body, statusCode, err := client.send(req, newRequestOptions(opts))
 	if err != nil {
 		return err
 	}
 
 	if returnData != nil {
 		if err := json.Unmarshal(body, returnData); err != nil {
-			return NewRequestError(statusCode, fmt.Errorf("could not unmarshal response body: %v", string(body)))
+			return NewRequestError(statusCode, fmt.Errorf("could not unmarshal response body: %v", redactBody(body)))
 		}
 	}
 
@@ -199,8 +293,14 @@ func (client *Client) DeleteWithResponse(urlPath string, sendData, returnData in
 }
 
 // GETWithParams performs a GET request with query parameters
-func (client *Client) GETWithParams(path string, params map[string]string, v interface{}) error {
-	req, err := http.NewRequest("GET", client.HostURL+path, nil)
+func (client *Client) GETWithParams(path string, params map[string]string, v interface{}, opts ...RequestOption) error {
+	return client.GETWithParamsContext(context.Background(), path, params, v, opts...)
+}
+
+// GETWithParamsContext performs a GET request with query parameters. The
+// request is abandoned when ctx is done.
+func (client *Client) GETWithParamsContext(ctx context.Context, path string, params map[string]string, v interface{}, opts ...RequestOption) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, client.HostURL+path, nil)
 	if err != nil {
 		return err
 	}
@@ -212,14 +312,14 @@ func (client *Client) GETWithParams(path string, params map[string]string, v int
 	}
 	req.URL.RawQuery = q.Encode()
 
-	body, _, err := client.doRequest(req)
+	body, _, err := client.send(req, newRequestOptions(opts))
 	if err != nil {
 		return err
 	}
 
 	if v != nil {
 		if err := json.Unmarshal(body, v); err != nil {
-			return fmt.Errorf("could not unmarshal response body: %v", string(body))
+			return fmt.Errorf("could not unmarshal response body: %v", redactBody(body))
 		}
 	}
 
@@ -0,0 +1,75 @@
+package kionclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestClient returns a client pointed at the given test server.
+func newTestClient(t *testing.T, url string) *Client {
+	t.Helper()
+	client, err := NewClient(url, "test-key", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestNewClientInvalidURL(t *testing.T) {
+	for _, kionURL := range []string{"", "kion.example.com", "ftp://kion.example.com", "https://", "http://[::1"} {
+		client, err := NewClient(kionURL, "test-key", "/api", false)
+		assert.Nil(t, client, kionURL)
+		assert.ErrorIs(t, err, ErrInvalidURL, kionURL)
+	}
+
+	client, err := NewClient("https://kion.example.com/", "test-key", "/api/", false)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://kion.example.com/api", client.HostURL)
+}
+
+func TestGETContextCanceled(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := newTestClient(t, server.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := client.GETContext(ctx, "/v3/ou", nil)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestRetryStopsWhenContextDone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "10")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := client.DELETEContext(ctx, "/v3/ou/1", nil)
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
@@ -0,0 +1,164 @@
+package kionclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Sentinel errors returned (wrapped in a RequestError) by the client. Use
+// errors.Is to test for them, or errors.As with *RequestError to access the
+// status code and the parsed Kion error message.
+var (
+	ErrNotFound     = errors.New("kion: object not found")
+	ErrConflict     = errors.New("kion: conflicting object")
+	ErrUnauthorized = errors.New("kion: unauthorized")
+	ErrForbidden    = errors.New("kion: forbidden")
+	ErrValidation   = errors.New("kion: validation failed")
+
+	// ErrInvalidURL is returned by NewClient when the Kion URL is not usable.
+	ErrInvalidURL = errors.New("kion: invalid URL")
+)
+
+// RequestError is returned by the client when a request could not be sent or
+// when Kion answered with a non-2xx status code.
+type RequestError struct {
+	StatusCode int
+	Err        error
+
+	// Message is the human readable message from Kion's error envelope, if any.
+	Message string
+	// FieldErrors lists the per-field validation errors reported by Kion, if any.
+	FieldErrors []FieldError
+}
+
+// FieldError is a validation error Kion attached to a single request field.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (r RequestError) Error() string {
+	return r.Err.Error()
+}
+
+func (r RequestError) Unwrap() error {
+	return r.Err
+}
+
+// Is lets errors.Is match a RequestError against the sentinel errors based
+// on its status code.
+func (r RequestError) Is(target error) bool {
+	sentinel := sentinelForStatus(r.StatusCode)
+	return sentinel != nil && target == sentinel
+}
+
+func NewRequestError(statusCode int, err error) error {
+	return &RequestError{StatusCode: statusCode, Err: err}
+}
+
+// newResponseError builds a RequestError for a non-2xx response, parsing
+// Kion's JSON error envelope when the body contains one. Sensitive fields are
+// redacted from the body included in the error message.
+func newResponseError(req *http.Request, statusCode int, body []byte) error {
+	message, fieldErrors := parseErrorBody(body)
+	return &RequestError{
+		StatusCode:  statusCode,
+		Err:         fmt.Errorf("url: %s, method: %s, status: %d, body: %s", req.URL.String(), req.Method, statusCode, redactBody(body)),
+		Message:     message,
+		FieldErrors: fieldErrors,
+	}
+}
+
+// sentinelForStatus maps an HTTP status code to its sentinel error.
+func sentinelForStatus(statusCode int) error {
+	switch statusCode {
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrConflict
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ErrValidation
+	}
+	return nil
+}
+
+// errorEnvelope is the JSON body Kion sends along with an error status.
+type errorEnvelope struct {
+	Message string          `json:"message"`
+	Error   string          `json:"error"`
+	Errors  json.RawMessage `json:"errors"`
+}
+
+// parseErrorBody extracts the message and any field errors from a Kion error
+// body. Bodies that are not JSON yield an empty result.
+func parseErrorBody(body []byte) (string, []FieldError) {
+	var env errorEnvelope
+	if err := json.Unmarshal(body, &env); err != nil {
+		return "", nil
+	}
+
+	message := strings.TrimSpace(env.Message)
+	if message == "" {
+		message = strings.TrimSpace(env.Error)
+	}
+
+	return message, parseFieldErrors(env.Errors)
+}
+
+// parseFieldErrors accepts the shapes Kion uses for field errors: a list of
+// {field, message} objects, or an object keyed by field name whose values are
+// a message or a list of messages.
+func parseFieldErrors(raw json.RawMessage) []FieldError {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var list []struct {
+		Field   string `json:"field"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(raw, &list); err == nil {
+		fieldErrors := make([]FieldError, 0, len(list))
+		for _, item := range list {
+			if item.Field == "" && item.Message == "" {
+				continue
+			}
+			fieldErrors = append(fieldErrors, FieldError{Field: item.Field, Message: item.Message})
+		}
+		return fieldErrors
+	}
+
+	var byField map[string]interface{}
+	if err := json.Unmarshal(raw, &byField); err != nil {
+		return nil
+	}
+
+	fields := make([]string, 0, len(byField))
+	for field := range byField {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	fieldErrors := make([]FieldError, 0, len(byField))
+	for _, field := range fields {
+		switch v := byField[field].(type) {
+		case string:
+			fieldErrors = append(fieldErrors, FieldError{Field: field, Message: v})
+		case []interface{}:
+			for _, m := range v {
+				fieldErrors = append(fieldErrors, FieldError{Field: field, Message: fmt.Sprint(m)})
+			}
+		default:
+			fieldErrors = append(fieldErrors, FieldError{Field: field, Message: fmt.Sprint(v)})
+		}
+	}
+	return fieldErrors
+}
@@ -0,0 +1,83 @@
+package kionclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestErrorIs(t *testing.T) {
+	cases := map[int]error{
+		http.StatusNotFound:            ErrNotFound,
+		http.StatusConflict:            ErrConflict,
+		http.StatusUnauthorized:        ErrUnauthorized,
+		http.StatusForbidden:           ErrForbidden,
+		http.StatusBadRequest:          ErrValidation,
+		http.StatusUnprocessableEntity: ErrValidation,
+	}
+
+	for status, sentinel := range cases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		}))
+
+		err := newTestClient(t, server.URL).GET("/v3/ou/1", nil)
+		server.Close()
+
+		// Callers usually wrap the client error with their own context.
+		wrapped := fmt.Errorf("unable to read OU: %w", err)
+		assert.True(t, errors.Is(wrapped, sentinel), "status %d", status)
+
+		var reqErr *RequestError
+		assert.True(t, errors.As(wrapped, &reqErr))
+		assert.Equal(t, status, reqErr.StatusCode)
+	}
+
+	err := NewRequestError(http.StatusInternalServerError, errors.New("boom"))
+	assert.False(t, errors.Is(err, ErrNotFound))
+	assert.False(t, errors.Is(err, ErrValidation))
+}
+
+func TestParseErrorBody(t *testing.T) {
+	message, fieldErrors := parseErrorBody([]byte(`{"status":400,"message":"Invalid request","errors":[{"field":"name","message":"is required"}]}`))
+	assert.Equal(t, "Invalid request", message)
+	assert.Equal(t, []FieldError{{Field: "name", Message: "is required"}}, fieldErrors)
+
+	message, fieldErrors = parseErrorBody([]byte(`{"error":"Bad input","errors":{"parent_ou_id":["must be positive","is required"],"name":"too long"}}`))
+	assert.Equal(t, "Bad input", message)
+	assert.Equal(t, []FieldError{
+		{Field: "name", Message: "too long"},
+		{Field: "parent_ou_id", Message: "must be positive"},
+		{Field: "parent_ou_id", Message: "is required"},
+	}, fieldErrors)
+
+	message, fieldErrors = parseErrorBody([]byte(`<html>Bad Gateway</html>`))
+	assert.Empty(t, message)
+	assert.Empty(t, fieldErrors)
+}
+
+func TestHandleError(t *testing.T) {
+	assert.Nil(t, HandleError(nil))
+
+	diags := HandleError(errors.New("plain"))
+	assert.Len(t, diags, 1)
+	assert.Equal(t, "error occurred: plain", diags[0].Summary)
+
+	err := fmt.Errorf("unable to create OU: %w", &RequestError{
+		StatusCode:  http.StatusBadRequest,
+		Err:         errors.New("status: 400"),
+		Message:     "Invalid request",
+		FieldErrors: []FieldError{{Field: "owner_users.0.id", Message: "unknown user"}},
+	})
+	diags = HandleError(err)
+	assert.Len(t, diags, 2)
+	assert.Equal(t, "Kion rejected the request: Invalid request", diags[0].Summary)
+	assert.Equal(t, "unable to create OU: status: 400", diags[0].Detail)
+	assert.Equal(t, "Invalid value for owner_users.0.id", diags[1].Summary)
+	assert.Equal(t, cty.GetAttrPath("owner_users").IndexInt(0).GetAttr("id"), diags[1].AttributePath)
+}
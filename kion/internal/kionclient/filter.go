@@ -4,11 +4,57 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// Operators of a filter. A filter matches when its field matches any of its
+// values, except for the negated operators, which match when the field
+// matches none of them.
+const (
+	FilterEquals    = "equals"
+	FilterNotEquals = "not_equals"
+	FilterContains  = "contains"
+	FilterPrefix    = "prefix"
+	FilterIn        = "in"
+	FilterGt        = "gt"
+	FilterGte       = "gte"
+	FilterLt        = "lt"
+	FilterLte       = "lte"
+	FilterExists    = "exists"
+	FilterNotExists = "not_exists"
+)
+
+// FilterOperators are the valid values of the operator of a filter.
+var FilterOperators = []string{
+	FilterEquals,
+	FilterNotEquals,
+	FilterContains,
+	FilterPrefix,
+	FilterIn,
+	FilterGt,
+	FilterGte,
+	FilterLt,
+	FilterLte,
+	FilterExists,
+	FilterNotExists,
+}
+
+// filterDateLayouts are the date formats the ordered operators compare
+// values in when they are not both numbers, e.g. created_at or a datecode.
+// Kion writes datecodes both as 2024-03 and as 202403.
+var filterDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"2006-01",
+	"200601",
+}
+
 // Filterable holds an array of filters that can be applied to data.
 type Filterable struct {
 	arr []Filter
@@ -24,13 +70,17 @@ func NewFilterable(d *schema.ResourceData) *Filterable {
 		filterName, nameOk := fi["name"].(string)
 		filterValues, valuesOk := fi["values"].([]interface{})
 		filterRegex, regexOk := fi["regex"].(bool)
+		filterOperator, _ := fi["operator"].(string)
+		filterCaseInsensitive, _ := fi["case_insensitive"].(bool)
 
 		if nameOk && valuesOk {
 			f := Filter{
-				key:    filterName,
-				keys:   strings.Split(filterName, "."),
-				values: filterValues,
-				regex:  regexOk && filterRegex,
+				key:             filterName,
+				keys:            strings.Split(filterName, "."),
+				values:          filterValues,
+				regex:           regexOk && filterRegex,
+				operator:        filterOperator,
+				caseInsensitive: filterCaseInsensitive,
 			}
 			arr = append(arr, f)
 		} else {
@@ -51,8 +101,21 @@ func (f *Filterable) Match(m map[string]interface{}) (bool, error) {
 	}
 
 	for _, filter := range f.arr {
+		operator, negate := filter.baseOperator()
+		if filter.regex && operator != FilterEquals {
+			return false, fmt.Errorf("regex cannot be combined with the '%v' operator in the '%v' filter", filter.operator, filter.key)
+		}
+
+		values := filter.values
+		if operator == FilterExists {
+			// The values are not used, but the field is looked up once.
+			values = []interface{}{nil}
+		} else if len(values) == 0 {
+			return false, fmt.Errorf("the '%v' filter needs at least one value for the '%v' operator", filter.key, operator)
+		}
+
 		match := false
-		for _, filterValue := range filter.values {
+		for _, filterValue := range values {
 			matched, err := filter.DeepMatch(filter.keys, m, filterValue)
 			if err != nil {
 				return false, err
@@ -62,7 +125,7 @@ func (f *Filterable) Match(m map[string]interface{}) (bool, error) {
 				break
 			}
 		}
-		if !match {
+		if match == negate {
 			return false, nil
 		}
 	}
@@ -72,14 +135,30 @@ func (f *Filterable) Match(m map[string]interface{}) (bool, error) {
 
 // Filter represents a single filter criterion that can be applied to data.
 type Filter struct {
-	key    string
-	keys   []string
-	values []interface{}
-	regex  bool
+	key             string
+	keys            []string
+	values          []interface{}
+	regex           bool
+	operator        string
+	caseInsensitive bool
+}
+
+// baseOperator returns the operator DeepMatch compares values with and
+// whether its result is negated, e.g. equals for not_equals.
+func (f *Filter) baseOperator() (string, bool) {
+	switch f.operator {
+	case "", FilterIn:
+		return FilterEquals, false
+	case FilterNotEquals:
+		return FilterEquals, true
+	case FilterNotExists:
+		return FilterExists, true
+	}
+	return f.operator, false
 }
 
 // DeepMatch is a recursive function used to match deeply nested fields within a map.
-// It supports both exact matching and regex-based matching.
+// It supports the filter operators and regex-based matching.
 func (f *Filter) DeepMatch(keys []string, m map[string]interface{}, filterValue interface{}) (bool, error) {
 	val, ok := m[keys[0]]
 	if !ok {
@@ -90,14 +169,7 @@ func (f *Filter) DeepMatch(keys []string, m map[string]interface{}, filterValue
 		if _, ok := val.([]interface{}); ok {
 			return false, fmt.Errorf("filter key (%v) references an array instead of a field: %v", f.key, fmt.Sprint(val))
 		}
-		if f.regex {
-			re, err := regexp.Compile(fmt.Sprint(filterValue))
-			if err != nil {
-				return false, fmt.Errorf("invalid regular expression '%v' for '%v' filter", filterValue, f.key)
-			}
-			return re.MatchString(fmt.Sprint(val)), nil
-		}
-		return fmt.Sprint(val) == fmt.Sprint(filterValue), nil
+		return f.matchValue(val, filterValue)
 	}
 
 	if x, ok := val.([]interface{}); ok {
@@ -118,3 +190,99 @@ func (f *Filter) DeepMatch(keys []string, m map[string]interface{}, filterValue
 
 	return false, nil
 }
+
+// matchValue compares a single field value with a filter value.
+func (f *Filter) matchValue(val interface{}, filterValue interface{}) (bool, error) {
+	operator, _ := f.baseOperator()
+	if operator == FilterExists {
+		return val != nil && fmt.Sprint(val) != "", nil
+	}
+
+	v, fv := fmt.Sprint(val), fmt.Sprint(filterValue)
+
+	if f.regex {
+		pattern := fv
+		if f.caseInsensitive {
+			pattern = "(?i)" + pattern
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return false, fmt.Errorf("invalid regular expression '%v' for '%v' filter", filterValue, f.key)
+		}
+		return re.MatchString(v), nil
+	}
+
+	switch operator {
+	case FilterEquals, FilterContains, FilterPrefix:
+		// Numbers and dates are compared as they are, since lowercasing
+		// breaks parsing e.g. the "T" and "Z" of an RFC 3339 time.
+		if f.caseInsensitive {
+			v, fv = strings.ToLower(v), strings.ToLower(fv)
+		}
+		switch operator {
+		case FilterEquals:
+			return v == fv, nil
+		case FilterContains:
+			return strings.Contains(v, fv), nil
+		}
+		return strings.HasPrefix(v, fv), nil
+	case FilterGt, FilterGte, FilterLt, FilterLte:
+		c, ok, err := f.compareOrdered(v, fv)
+		if err != nil || !ok {
+			return false, err
+		}
+		switch operator {
+		case FilterGt:
+			return c > 0, nil
+		case FilterGte:
+			return c >= 0, nil
+		case FilterLt:
+			return c < 0, nil
+		default:
+			return c <= 0, nil
+		}
+	}
+
+	return false, fmt.Errorf("unknown operator '%v' for '%v' filter", f.operator, f.key)
+}
+
+// compareOrdered compares a field value with a filter value as numbers or,
+// unless both are numbers, as dates, so a datecode like 202403 compares with
+// 2024-03. It returns false if the field value cannot be compared, e.g.
+// because it is empty.
+func (f *Filter) compareOrdered(v, fv string) (int, bool, error) {
+	a, aErr := strconv.ParseFloat(v, 64)
+	b, bErr := strconv.ParseFloat(fv, 64)
+	if aErr == nil && bErr == nil {
+		switch {
+		case a < b:
+			return -1, true, nil
+		case a > b:
+			return 1, true, nil
+		}
+		return 0, true, nil
+	}
+
+	bt, ok := parseFilterDate(fv)
+	if !ok {
+		if bErr == nil {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("the '%v' operator of the '%v' filter needs a number or a date, got '%v'", f.operator, f.key, fv)
+	}
+	at, ok := parseFilterDate(v)
+	if !ok {
+		return 0, false, nil
+	}
+	return at.Compare(bt), true, nil
+}
+
+// parseFilterDate parses s in the first of filterDateLayouts it matches.
+func parseFilterDate(s string) (time.Time, bool) {
+	for _, layout := range filterDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -1,8 +1,10 @@
 package kionclient
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -272,3 +274,118 @@ func TestExtractValue(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.False(t, v)
 }
+
+func TestMatchOperators(t *testing.T) {
+	data := make(map[string]interface{})
+	data["id"] = 200
+	data["name"] = "SystemReadOnlyAccess"
+	data["description"] = ""
+	data["budget"] = 1500.5
+	data["created_at"] = "2024-03-15T10:00:00Z"
+	data["start_datecode"] = "2024-03"
+	data["end_datecode"] = ""
+	data["move_datecode"] = 202403
+	data["owner_users"] = inflateIntArray([]int{300, 100})
+
+	tests := []struct {
+		name            string
+		key             string
+		operator        string
+		values          []interface{}
+		regex           bool
+		caseInsensitive bool
+		want            bool
+		wantErr         bool
+	}{
+		{name: "default equals", key: "name", values: []interface{}{"SystemReadOnlyAccess"}, want: true},
+		{name: "equals is case sensitive", key: "name", operator: FilterEquals, values: []interface{}{"systemreadonlyaccess"}},
+		{name: "equals case insensitive", key: "name", operator: FilterEquals, values: []interface{}{"systemreadonlyaccess"}, caseInsensitive: true, want: true},
+		{name: "not_equals", key: "name", operator: FilterNotEquals, values: []interface{}{"Other", "Another"}, want: true},
+		{name: "not_equals any value", key: "name", operator: FilterNotEquals, values: []interface{}{"Other", "SystemReadOnlyAccess"}},
+		{name: "not_equals nested", key: "owner_users.id", operator: FilterNotEquals, values: []interface{}{"100"}},
+		{name: "contains", key: "name", operator: FilterContains, values: []interface{}{"ReadOnly"}, want: true},
+		{name: "contains case insensitive", key: "name", operator: FilterContains, values: []interface{}{"readonly"}, caseInsensitive: true, want: true},
+		{name: "prefix", key: "name", operator: FilterPrefix, values: []interface{}{"System"}, want: true},
+		{name: "prefix mismatch", key: "name", operator: FilterPrefix, values: []interface{}{"ReadOnly"}},
+		{name: "in", key: "id", operator: FilterIn, values: []interface{}{"100", "200"}, want: true},
+		{name: "in nested", key: "owner_users.id", operator: FilterIn, values: []interface{}{"1", "300"}, want: true},
+		{name: "gt", key: "id", operator: FilterGt, values: []interface{}{"199"}, want: true},
+		{name: "gt equal", key: "id", operator: FilterGt, values: []interface{}{"200"}},
+		{name: "gte", key: "id", operator: FilterGte, values: []interface{}{"200"}, want: true},
+		{name: "lt float", key: "budget", operator: FilterLt, values: []interface{}{"1500.75"}, want: true},
+		{name: "lte", key: "budget", operator: FilterLte, values: []interface{}{"1500"}},
+		{name: "gt nested", key: "owner_users.id", operator: FilterGt, values: []interface{}{"250"}, want: true},
+		{name: "gt date", key: "created_at", operator: FilterGt, values: []interface{}{"2024-01-01"}, want: true},
+		{name: "lt date", key: "created_at", operator: FilterLt, values: []interface{}{"2024-03-15T09:00:00Z"}},
+		{name: "gte datecode", key: "start_datecode", operator: FilterGte, values: []interface{}{"2024-03"}, want: true},
+		{name: "lt datecode", key: "start_datecode", operator: FilterLt, values: []interface{}{"2024-02"}},
+		{name: "gt date case insensitive", key: "created_at", operator: FilterGt, values: []interface{}{"2024-03-15T09:00:00Z"}, caseInsensitive: true, want: true},
+		{name: "gte datecode without dash", key: "start_datecode", operator: FilterGte, values: []interface{}{"202403"}, want: true},
+		{name: "lt datecode with dash", key: "move_datecode", operator: FilterLt, values: []interface{}{"2024-04"}, want: true},
+		{name: "gt datecode numbers", key: "move_datecode", operator: FilterGt, values: []interface{}{"202403"}},
+		{name: "number does not order text", key: "name", operator: FilterGt, values: []interface{}{"100"}},
+		{name: "empty field is not ordered", key: "end_datecode", operator: FilterLt, values: []interface{}{"2030-01"}},
+		{name: "ordered needs number or date", key: "name", operator: FilterGt, values: []interface{}{"abc"}, wantErr: true},
+		{name: "exists", key: "name", operator: FilterExists, want: true},
+		{name: "exists empty", key: "description", operator: FilterExists},
+		{name: "not_exists", key: "description", operator: FilterNotExists, want: true},
+		{name: "exists unknown field", key: "missing", operator: FilterExists, wantErr: true},
+		{name: "values required", key: "name", operator: FilterContains, wantErr: true},
+		{name: "regex case insensitive", key: "name", values: []interface{}{`^system`}, regex: true, caseInsensitive: true, want: true},
+		{name: "regex not_equals", key: "name", operator: FilterNotEquals, values: []interface{}{`^System`}, regex: true},
+		{name: "regex with contains", key: "name", operator: FilterContains, values: []interface{}{`^System`}, regex: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filterable := Filterable{
+				arr: []Filter{{
+					key:             tt.key,
+					keys:            strings.Split(tt.key, "."),
+					values:          tt.values,
+					regex:           tt.regex,
+					operator:        tt.operator,
+					caseInsensitive: tt.caseInsensitive,
+				}},
+			}
+			v, err := filterable.Match(data)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, v)
+		})
+	}
+}
+
+func TestNewFilterable(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"filter": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name":             {Type: schema.TypeString, Required: true},
+					"values":           {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+					"regex":            {Type: schema.TypeBool, Optional: true, Default: false},
+					"operator":         {Type: schema.TypeString, Optional: true, Default: FilterEquals},
+					"case_insensitive": {Type: schema.TypeBool, Optional: true, Default: false},
+				},
+			},
+		},
+	}, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{"name": "name", "values": []interface{}{"system"}, "operator": FilterPrefix, "case_insensitive": true},
+			map[string]interface{}{"name": "description", "operator": FilterNotExists},
+		},
+	})
+
+	f := NewFilterable(d)
+	v, err := f.Match(map[string]interface{}{"name": "SystemReadOnlyAccess", "description": ""})
+	assert.NoError(t, err)
+	assert.True(t, v)
+
+	v, err = f.Match(map[string]interface{}{"name": "SystemReadOnlyAccess", "description": "set"})
+	assert.NoError(t, err)
+	assert.False(t, v)
+}
@@ -2,11 +2,16 @@ package kionclient
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -290,6 +295,43 @@ func OptionalValue[T any](d *schema.ResourceData, fieldname string) *T {
 	return nil
 }
 
+// WriteOnlyString retrieves the value of a write-only string attribute from the
+// raw configuration, since write-only values never reach the plan or state.
+// It returns an empty string if the attribute is not configured.
+func WriteOnlyString(d *schema.ResourceData, fieldname string) (string, error) {
+	v, diags := d.GetRawConfigAt(cty.GetAttrPath(fieldname))
+	if diags.HasError() {
+		return "", fmt.Errorf("unable to read %s: %s", fieldname, diags[0].Summary)
+	}
+	if !v.Type().Equals(cty.String) || v.IsNull() || !v.IsKnown() {
+		return "", nil
+	}
+	return v.AsString(), nil
+}
+
+// DataSourceID returns an ID for a data source that is derived from a hash of
+// the values of the given input attributes, such as "filter", so the ID only
+// changes when the inputs do. Include "list" to also change it with the result.
+func DataSourceID(d *schema.ResourceData, fieldnames ...string) string {
+	values := make(map[string]interface{}, len(fieldnames))
+	for _, k := range fieldnames {
+		v := d.Get(k)
+		if set, ok := v.(*schema.Set); ok {
+			v = set.List()
+		}
+		values[k] = v
+	}
+
+	// Maps are marshaled with sorted keys, so equal inputs give equal IDs.
+	b, err := json.Marshal(values)
+	if err != nil {
+		// Inputs read from a schema always marshal; fall back to the names.
+		b = []byte(strings.Join(fieldnames, ","))
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:8])
+}
+
 // AssociationChanged compares the old and new values of a field that contains an array of IDs
 // (e.g., user or group IDs) and determines which IDs were added, removed, or changed.
 // It returns slices of IDs to add and remove, a boolean indicating if there was a change, and any error encountered.
@@ -510,13 +552,99 @@ func ParseResourceID(resourceID string, expectedParts int, idNames ...string) ([
 	return ids, nil
 }
 
-// HandleError converts an error to a diagnostic object.
-// It is a simple utility function that returns a diagnostic with an error message if an error is present.
+// HandleError converts an error to diagnostics. Errors returned by the Kion API
+// get a summary describing the kind of failure, and every field error Kion
+// reported becomes its own diagnostic pointing at the matching attribute.
 func HandleError(err error) diag.Diagnostics {
-	if err != nil {
+	if err == nil {
+		return nil
+	}
+
+	var reqErr *RequestError
+	if !errors.As(err, &reqErr) {
 		return diag.Errorf("error occurred: %v", err)
 	}
-	return nil
+
+	summary := errorSummary(err)
+	if reqErr.Message != "" {
+		summary = fmt.Sprintf("%s: %s", summary, reqErr.Message)
+	}
+
+	diags := diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   err.Error(),
+	}}
+
+	for _, fieldErr := range reqErr.FieldErrors {
+		d := diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid value",
+			Detail:   fieldErr.Message,
+		}
+		if fieldErr.Field != "" {
+			d.Summary = fmt.Sprintf("Invalid value for %s", fieldErr.Field)
+			d.AttributePath = attributePath(fieldErr.Field)
+		}
+		diags = append(diags, d)
+	}
+
+	return diags
+}
+
+// RemoveFromState handles drift for objects deleted outside of Terraform. It
+// logs a warning and clears the resource ID so Terraform plans to re-create
+// the object.
+func RemoveFromState(ctx context.Context, d *schema.ResourceData, resourceType string) {
+	tflog.Warn(ctx, "Kion object not found, removing it from state", map[string]interface{}{
+		"resource_type": resourceType,
+		"id":            d.Id(),
+	})
+	d.SetId("")
+}
+
+// RemoveFromStateIfNotFound calls RemoveFromState when err is a Kion 404 and
+// returns true, in which case the caller's Read function should return without
+// an error.
+func RemoveFromStateIfNotFound(ctx context.Context, d *schema.ResourceData, resourceType string, err error) bool {
+	if !errors.Is(err, ErrNotFound) {
+		return false
+	}
+
+	RemoveFromState(ctx, d, resourceType)
+	return true
+}
+
+// errorSummary returns a short description of the kind of Kion API failure.
+func errorSummary(err error) string {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return "Kion object not found"
+	case errors.Is(err, ErrUnauthorized):
+		return "Kion API authentication failed"
+	case errors.Is(err, ErrForbidden):
+		return "Kion API permission denied"
+	case errors.Is(err, ErrConflict):
+		return "Kion object conflict"
+	case errors.Is(err, ErrValidation):
+		return "Kion rejected the request"
+	default:
+		return "Kion API error"
+	}
+}
+
+// attributePath converts a dotted Kion field name such as "budget.0.amount"
+// into a Terraform attribute path.
+func attributePath(field string) cty.Path {
+	var p cty.Path
+	for _, step := range strings.Split(field, ".") {
+		if idx, err := strconv.Atoi(step); err == nil {
+			p = p.IndexInt(idx)
+			continue
+		}
+		p = p.GetAttr(step)
+	}
+	return p
 }
 
 // AtLeastOneFieldPresent checks a map of fields (by their names) to ensure that at least one field has a value.
@@ -0,0 +1,67 @@
+package kionclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoveFromStateIfNotFound(t *testing.T) {
+	testSchema := map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Optional: true},
+	}
+	ctx := context.Background()
+
+	d := schema.TestResourceDataRaw(t, testSchema, map[string]interface{}{"name": "test"})
+	d.SetId("10")
+	err := fmt.Errorf("unable to read: %w", NewRequestError(http.StatusNotFound, errors.New("status: 404")))
+	assert.True(t, RemoveFromStateIfNotFound(ctx, d, "kion_ou", err))
+	assert.Equal(t, "", d.Id())
+
+	d.SetId("10")
+	err = NewRequestError(http.StatusInternalServerError, errors.New("status: 500"))
+	assert.False(t, RemoveFromStateIfNotFound(ctx, d, "kion_ou", err))
+	assert.Equal(t, "10", d.Id())
+
+	assert.False(t, RemoveFromStateIfNotFound(ctx, d, "kion_ou", nil))
+	assert.Equal(t, "10", d.Id())
+}
+
+func TestDataSourceID(t *testing.T) {
+	testSchema := map[string]*schema.Schema{
+		"filter": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name":   {Type: schema.TypeString, Required: true},
+					"values": {Type: schema.TypeList, Required: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				},
+			},
+		},
+		"ids": {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeInt}},
+	}
+	id := func(raw map[string]interface{}) string {
+		return DataSourceID(schema.TestResourceDataRaw(t, testSchema, raw), "filter", "ids")
+	}
+
+	filter := map[string]interface{}{
+		"filter": []interface{}{map[string]interface{}{"name": "name", "values": []interface{}{"a"}}},
+		"ids":    []interface{}{3, 1, 2},
+	}
+	assert.Equal(t, id(filter), id(filter))
+	assert.Equal(t, id(filter), id(map[string]interface{}{
+		"filter": []interface{}{map[string]interface{}{"values": []interface{}{"a"}, "name": "name"}},
+		"ids":    []interface{}{2, 3, 1},
+	}))
+	assert.NotEqual(t, id(filter), id(map[string]interface{}{
+		"filter": []interface{}{map[string]interface{}{"name": "name", "values": []interface{}{"b"}}},
+		"ids":    []interface{}{3, 1, 2},
+	}))
+	assert.NotEqual(t, id(filter), id(map[string]interface{}{}))
+}
@@ -1,28 +1,170 @@
 package kionclient
 
 import (
+	"context"
 	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var supportedResourceTypes = []string{"account", "cloud-rule", "funding-source", "ou", "project"}
 
-func PutAppLabelIDs(client *Client, labels *[]AssociateLabel, resourceType string, resourceID string) error {
+func PutAppLabelIDs(ctx context.Context, client *Client, labels *[]AssociateLabel, resourceType string, resourceID string) error {
 	if !IsSupportedResourceType(resourceType) {
 		return fmt.Errorf("Error: %v", "Unsupported resource type for labels")
 	}
 
+	if client.CreateMissingLabels {
+		if err := createMissingLabels(ctx, client, *labels); err != nil {
+			return fmt.Errorf("Error: %v", err)
+		}
+	}
+
+	var previous map[string]interface{}
+	if client.DeleteUnusedLabels {
+		var err error
+		if previous, err = ReadResourceLabels(ctx, client, resourceType, resourceID); err != nil {
+			return fmt.Errorf("Error: %v", err)
+		}
+	}
+
 	req := AssociateLabels{
 		Labels: labels,
 	}
 
-	err := client.PUT(fmt.Sprintf("/v3/%s/%s/labels", resourceType, resourceID), req)
+	err := client.PUTContext(ctx, fmt.Sprintf("/v3/%s/%s/labels", resourceType, resourceID), req)
 	if err != nil {
 		return fmt.Errorf("Error: %v", err)
 	}
 
+	if previous != nil {
+		for _, label := range *labels {
+			if previous[label.Key] == label.Value {
+				delete(previous, label.Key)
+			}
+		}
+		// The labels are in place, so failing to clean up only warrants a
+		// warning.
+		if err := DeleteUnusedLabels(ctx, client, previous); err != nil {
+			tflog.Warn(ctx, "Unable to delete unused labels", map[string]interface{}{"error": err.Error()})
+		}
+	}
+
+	return nil
+}
+
+// createMissingLabels creates the labels that do not exist in Kion yet with
+// the client's MissingLabelColor.
+func createMissingLabels(ctx context.Context, client *Client, labels []AssociateLabel) error {
+	// Serialize creation so resources that are applied in parallel do not
+	// create the same label twice.
+	client.labelsMu.Lock()
+	defer client.labelsMu.Unlock()
+
+	existing, err := List[Label](ctx, client, "/v3/label", nil)
+	if err != nil {
+		return err
+	}
+	known := make(map[AssociateLabel]bool, len(existing))
+	for _, label := range existing {
+		known[AssociateLabel{Key: label.Key, Value: label.Value}] = true
+	}
+
+	for _, label := range labels {
+		if known[AssociateLabel{Key: label.Key, Value: label.Value}] {
+			continue
+		}
+		create := LabelCreate{Color: client.MissingLabelColor, Key: label.Key, Value: label.Value}
+		if _, err := client.POSTContext(ctx, "/v3/label", create); err != nil {
+			return fmt.Errorf("unable to create label %s=%s: %w", label.Key, label.Value, err)
+		}
+		tflog.Info(ctx, "Created missing Kion label", map[string]interface{}{"key": label.Key, "value": label.Value})
+	}
+	return nil
+}
+
+// DeleteUnusedResourceLabels runs DeleteUnusedLabels for the labels of a
+// destroyed resource. A failure is reported as a warning, as the resource
+// itself is gone.
+func DeleteUnusedResourceLabels(ctx context.Context, client *Client, d *schema.ResourceData) diag.Diagnostics {
+	labels, _ := d.Get("labels").(map[string]interface{})
+	if err := DeleteUnusedLabels(ctx, client, labels); err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Unable to delete unused labels",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err, d.Id()),
+		}}
+	}
 	return nil
 }
 
+// DeleteUnusedLabels deletes the labels in labels, a map of key to value,
+// that have the client's MissingLabelColor and are no longer associated with
+// any account, cloud rule, funding source, OU or project. It does nothing
+// unless the client's DeleteUnusedLabels is set.
+//
+// Kion has no endpoint that reports where a label is used, so the labels of
+// every object of the supported types are read. The cost grows with the size
+// of the Kion installation.
+func DeleteUnusedLabels(ctx context.Context, client *Client, labels map[string]interface{}) error {
+	if !client.DeleteUnusedLabels || len(labels) == 0 {
+		return nil
+	}
+
+	client.labelsMu.Lock()
+	defer client.labelsMu.Unlock()
+
+	existing, err := List[Label](ctx, client, "/v3/label", nil)
+	if err != nil {
+		return err
+	}
+	candidates := make(map[AssociateLabel]int)
+	for _, label := range existing {
+		if label.Color == client.MissingLabelColor && labels[label.Key] == label.Value {
+			candidates[AssociateLabel{Key: label.Key, Value: label.Value}] = label.ID
+		}
+	}
+
+	for _, resourceType := range supportedResourceTypes {
+		if len(candidates) == 0 {
+			return nil
+		}
+		objects, err := List[ObjectWithID](ctx, client, "/v3/"+resourceType, nil)
+		if err != nil {
+			return err
+		}
+		for _, object := range objects {
+			used, err := ReadResourceLabels(ctx, client, resourceType, strconv.Itoa(object.ID))
+			if err != nil {
+				return err
+			}
+			for key, value := range used {
+				delete(candidates, AssociateLabel{Key: key, Value: value.(string)})
+			}
+		}
+	}
+
+	for label, id := range candidates {
+		if err := client.DELETEContext(ctx, fmt.Sprintf("/v3/label/%d", id), nil); err != nil {
+			return fmt.Errorf("unable to delete label %s=%s: %w", label.Key, label.Value, err)
+		}
+		tflog.Info(ctx, "Deleted unused Kion label", map[string]interface{}{"key": label.Key, "value": label.Value})
+	}
+	return nil
+}
+
+// SupportedResourceTypes returns the object types labels can be associated
+// with, e.g. "cloud-rule".
+func SupportedResourceTypes() []string {
+	return slices.Clone(supportedResourceTypes)
+}
+
 func IsSupportedResourceType(resourceType string) bool {
 	for _, item := range supportedResourceTypes {
 		if resourceType == item {
@@ -32,13 +174,13 @@ func IsSupportedResourceType(resourceType string) bool {
 	return false
 }
 
-func ReadResourceLabels(client *Client, resourceType string, resourceID string) (map[string]interface{}, error) {
+func ReadResourceLabels(ctx context.Context, client *Client, resourceType string, resourceID string) (map[string]interface{}, error) {
 	if !IsSupportedResourceType(resourceType) {
 		return nil, fmt.Errorf("Error: %v", "Unsupported resource type for labels")
 	}
 
 	labelsResp := new(AssociatedLabelsResponse)
-	err := client.GET(fmt.Sprintf("/v3/%s/%s/labels", resourceType, resourceID), labelsResp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/%s/%s/labels", resourceType, resourceID), labelsResp)
 	if err != nil {
 		return nil, err
 	}
@@ -51,3 +193,167 @@ func ReadResourceLabels(client *Client, resourceType string, resourceID string)
 
 	return labelData, nil
 }
+
+// DefaultLabelsDiff is a CustomizeDiff that merges the provider default_labels
+// into the "labels" attribute, so the plan shows the labels that are applied.
+// Labels set on the resource win over defaults with the same key. The
+// attribute must be Optional and Computed.
+func DefaultLabelsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return setEffectiveLabels(d, meta, true)
+}
+
+// AccountDefaultLabelsDiff is DefaultLabelsDiff for accounts. Only accounts in
+// a project have labels, so accounts in the account cache get no defaults.
+func AccountDefaultLabelsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	inProject := d.Get("project_id").(int) != 0
+	if raw := d.GetRawConfig(); !raw.IsNull() {
+		// project_id may be unknown until the project is created.
+		inProject = !raw.GetAttr("project_id").IsNull()
+	}
+	return setEffectiveLabels(d, meta, inProject)
+}
+
+// setEffectiveLabels plans the configured labels, merged with the default
+// labels of the client when withDefaults is true. Without configured labels or
+// defaults the labels are left unmanaged, so labels attached with
+// kion_label_association are kept.
+func setEffectiveLabels(d *schema.ResourceDiff, meta interface{}, withDefaults bool) error {
+	var defaults map[string]string
+	if client, ok := meta.(*Client); ok && withDefaults {
+		defaults = client.DefaultLabels
+	}
+
+	labels := make(map[string]interface{})
+	if raw := d.GetRawConfig(); !raw.IsNull() {
+		configured := raw.GetAttr("labels")
+		if !configured.IsWhollyKnown() {
+			return d.SetNewComputed("labels")
+		}
+		if configured.IsNull() && len(defaults) == 0 {
+			return nil
+		}
+		if !configured.IsNull() {
+			for key, value := range configured.AsValueMap() {
+				if !value.IsNull() {
+					labels[key] = value.AsString()
+				}
+			}
+		}
+	} else {
+		for key, value := range d.Get("labels").(map[string]interface{}) {
+			labels[key] = value
+		}
+	}
+
+	for key, value := range defaults {
+		if _, ok := labels[key]; !ok {
+			labels[key] = value
+		}
+	}
+
+	if d.NewValueKnown("labels") && reflect.DeepEqual(d.Get("labels"), labels) {
+		return nil
+	}
+	return d.SetNew("labels", labels)
+}
+
+// FindLabel returns the label key=value. A missing label is created when the
+// client's CreateMissingLabels is set and is an ErrNotFound error otherwise.
+func FindLabel(ctx context.Context, client *Client, key, value string) (*Label, error) {
+	if client.CreateMissingLabels {
+		if err := createMissingLabels(ctx, client, []AssociateLabel{{Key: key, Value: value}}); err != nil {
+			return nil, err
+		}
+	}
+
+	labels, err := List[Label](ctx, client, "/v3/label", nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, label := range labels {
+		if label.Key == key && label.Value == value {
+			return &label, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: label %s=%s does not exist", ErrNotFound, key, value)
+}
+
+// ReadResourceLabel returns the label with labelID if it is associated with
+// the object, or nil.
+func ReadResourceLabel(ctx context.Context, client *Client, resourceType string, resourceID string, labelID int) (*AssociateLabel, error) {
+	labels, err := readAssociatedLabels(ctx, client, resourceType, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, label := range labels {
+		if label.ID == labelID {
+			return &label, nil
+		}
+	}
+	return nil, nil
+}
+
+// AddResourceLabel associates label with an object and keeps the labels the
+// object already has.
+func AddResourceLabel(ctx context.Context, client *Client, resourceType string, resourceID string, label AssociateLabel) error {
+	return updateResourceLabels(ctx, client, resourceType, resourceID, func(labels []AssociateLabel) []AssociateLabel {
+		for _, existing := range labels {
+			if existing.ID == label.ID {
+				return labels
+			}
+		}
+		return append(labels, label)
+	})
+}
+
+// RemoveResourceLabel removes the label with labelID from an object and keeps
+// its other labels.
+func RemoveResourceLabel(ctx context.Context, client *Client, resourceType string, resourceID string, labelID int) error {
+	return updateResourceLabels(ctx, client, resourceType, resourceID, func(labels []AssociateLabel) []AssociateLabel {
+		return slices.DeleteFunc(labels, func(label AssociateLabel) bool {
+			return label.ID == labelID
+		})
+	})
+}
+
+// updateResourceLabels replaces the labels of an object with the result of
+// update. Changes of the same object are serialized, so associations applied
+// in parallel do not overwrite each other.
+func updateResourceLabels(ctx context.Context, client *Client, resourceType string, resourceID string, update func([]AssociateLabel) []AssociateLabel) error {
+	if !IsSupportedResourceType(resourceType) {
+		return fmt.Errorf("Error: %v", "Unsupported resource type for labels")
+	}
+
+	mu, _ := client.objectLabelsMu.LoadOrStore(resourceType+"/"+resourceID, new(sync.Mutex))
+	mu.(*sync.Mutex).Lock()
+	defer mu.(*sync.Mutex).Unlock()
+
+	labels, err := readAssociatedLabels(ctx, client, resourceType, resourceID)
+	if err != nil {
+		return err
+	}
+	updated := update(labels)
+	req := AssociateLabels{
+		Labels: &updated,
+	}
+	return client.PUTContext(ctx, fmt.Sprintf("/v3/%s/%s/labels", resourceType, resourceID), req)
+}
+
+// readAssociatedLabels returns the labels associated with an object.
+func readAssociatedLabels(ctx context.Context, client *Client, resourceType string, resourceID string) ([]AssociateLabel, error) {
+	if !IsSupportedResourceType(resourceType) {
+		return nil, fmt.Errorf("Error: %v", "Unsupported resource type for labels")
+	}
+
+	labelsResp := new(AssociatedLabelsResponse)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/%s/%s/labels", resourceType, resourceID), labelsResp, WithoutCache())
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make([]AssociateLabel, 0, len(labelsResp.Data))
+	for _, item := range labelsResp.Data {
+		labels = append(labels, AssociateLabel{ID: item.ID, Key: item.Key, Value: item.Value})
+	}
+	return labels, nil
+}
@@ -0,0 +1,155 @@
+package kionclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// HTTPLogSubsystem is the tflog subsystem requests to Kion are logged under.
+// Its level follows TF_LOG_PROVIDER unless TF_LOG_PROVIDER_KION_HTTP is set.
+// Method, URL, status and latency are logged at DEBUG, bodies at TRACE.
+const HTTPLogSubsystem = "kion_http"
+
+// redacted replaces the value of sensitive fields in logs and error messages.
+const redacted = "***"
+
+// sensitiveFields are JSON fields and HTTP headers whose values are never
+// logged. Keys are compared case-insensitively.
+var sensitiveFields = map[string]bool{
+	"apikey":            true,
+	"authorization":     true,
+	"password":          true,
+	"request_headers":   true,
+	"secret_access_key": true,
+	"session_token":     true,
+	"smtp_password":     true,
+	"token":             true,
+}
+
+// appAPIKeyPattern matches a Kion app API key, which is also redacted from
+// fields that are not sensitive by name, such as the "key" of a created app
+// API key.
+var appAPIKeyPattern = regexp.MustCompile(`^app_\d+_`)
+
+// signinTokenPattern matches the sign-in token of a federated console link,
+// such as the url returned by /v3/console-access, which grants access to the
+// console until it expires.
+var signinTokenPattern = regexp.MustCompile(`(?i)(SigninToken=)[^&#\s]+`)
+
+// requestIDHeaders are the response headers checked, in order, for an ID
+// that correlates a request with the Kion server logs.
+var requestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id"}
+
+// httpLogContext returns ctx with the kion_http subsystem logger set up.
+func httpLogContext(ctx context.Context) context.Context {
+	return tflog.NewSubsystem(ctx, HTTPLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_KION_HTTP"))
+}
+
+// logResponse logs a completed attempt of req. res is nil and err is set when
+// no response was received.
+func logResponse(ctx context.Context, req *http.Request, res *http.Response, body []byte, latency time.Duration, err error) {
+	fields := map[string]interface{}{
+		"method":     req.Method,
+		"url":        req.URL.String(),
+		"latency_ms": latency.Milliseconds(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+	if res == nil {
+		tflog.SubsystemDebug(ctx, HTTPLogSubsystem, "Kion API request failed", fields)
+		return
+	}
+
+	fields["status"] = res.StatusCode
+	for _, header := range requestIDHeaders {
+		if id := res.Header.Get(header); id != "" {
+			fields["request_id"] = id
+			break
+		}
+	}
+	tflog.SubsystemDebug(ctx, HTTPLogSubsystem, "Kion API request", fields)
+
+	fields["request_headers"] = redactHeaders(req.Header)
+	fields["request_body"] = redactBody(requestBody(req))
+	fields["response_body"] = redactBody(body)
+	tflog.SubsystemTrace(ctx, HTTPLogSubsystem, "Kion API request and response bodies", fields)
+}
+
+// requestBody returns a copy of the body of req without consuming it.
+func requestBody(req *http.Request) []byte {
+	if req.GetBody == nil {
+		return nil
+	}
+	rc, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer rc.Close()
+
+	body, _ := io.ReadAll(rc)
+	return body
+}
+
+// redactHeaders flattens the headers for logging and hides sensitive values.
+func redactHeaders(headers http.Header) map[string]string {
+	flat := make(map[string]string, len(headers))
+	for key, values := range headers {
+		if sensitiveFields[strings.ToLower(key)] {
+			flat[key] = redacted
+			continue
+		}
+		flat[key] = strings.Join(values, ", ")
+	}
+	return flat
+}
+
+// redactBody returns body as a string with the values of sensitive fields
+// replaced. Bodies that are not JSON are returned unchanged.
+func redactBody(body []byte) string {
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return string(body)
+	}
+
+	var out bytes.Buffer
+	enc := json.NewEncoder(&out)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(redactValue(v)); err != nil {
+		return string(body)
+	}
+	return strings.TrimSuffix(out.String(), "\n")
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if sensitiveFields[strings.ToLower(key)] && value != nil {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactValue(value)
+		}
+	case string:
+		if appAPIKeyPattern.MatchString(v) {
+			return redacted
+		}
+		return signinTokenPattern.ReplaceAllString(v, "${1}"+redacted)
+	}
+	return v
+}
@@ -0,0 +1,82 @@
+package kionclient
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactBody(t *testing.T) {
+	body := []byte(`{"data":{"name":"hook","smtp_password":"hunter2","request_headers":{"X-Key":"abc"},"items":[{"apikey":"app_1_secret","id":1}]}}`)
+	assert.Equal(t,
+		`{"data":{"items":[{"apikey":"***","id":1}],"name":"hook","request_headers":"***","smtp_password":"***"}}`,
+		redactBody(body))
+
+	assert.Equal(t, "<html>not json</html>", redactBody([]byte("<html>not json</html>")))
+	assert.Equal(t, `{"password":null}`, redactBody([]byte(`{"password":null}`)))
+	assert.Equal(t, `{"data":{"id":3,"key":"***"}}`, redactBody([]byte(`{"data":{"id":3,"key":"app_3_secret"}}`)))
+	assert.Equal(t,
+		`{"data":{"expiration":"2026-01-01T00:00:00Z","url":"https://signin.aws.amazon.com/federation?Action=login&SigninToken=***&Destination=https%3A%2F%2Fconsole.aws.amazon.com"}}`,
+		redactBody([]byte(`{"data":{"expiration":"2026-01-01T00:00:00Z","url":"https://signin.aws.amazon.com/federation?Action=login&SigninToken=abc-123_XYZ&Destination=https%3A%2F%2Fconsole.aws.amazon.com"}}`)))
+}
+
+func TestConsoleURLLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"url":"https://signin.aws.amazon.com/federation?Action=login&SigninToken=secret-token","expiration":"2026-01-01T00:00:00Z"},"status":200}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	client := newTestClient(t, server.URL)
+	consoleURL, err := GetConsoleURL(ctx, client, 1, 2)
+	assert.NoError(t, err)
+	assert.Contains(t, consoleURL.URL, "SigninToken=secret-token")
+
+	assert.NotContains(t, output.String(), "secret-token")
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Contains(t, entries[1]["response_body"], "SigninToken=***")
+}
+
+func TestRequestLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-42")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"bad webhook","request_headers":"X-Secret: abc"}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	client := newTestClient(t, server.URL)
+	_, err := client.POSTContext(ctx, "/v3/webhook", map[string]string{"name": "hook", "smtp_password": "hunter2"})
+	assert.Error(t, err)
+	assert.NotContains(t, err.Error(), "X-Secret")
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	debug := entries[0]
+	assert.Equal(t, "debug", debug["@level"])
+	assert.Equal(t, "provider."+HTTPLogSubsystem, debug["@module"])
+	assert.Equal(t, "POST", debug["method"])
+	assert.Equal(t, float64(http.StatusBadRequest), debug["status"])
+	assert.Equal(t, "req-42", debug["request_id"])
+	assert.Contains(t, debug, "latency_ms")
+
+	trace := entries[1]
+	assert.Equal(t, "trace", trace["@level"])
+	assert.Equal(t, `{"name":"hook","smtp_password":"***"}`, trace["request_body"])
+	assert.Equal(t, `{"message":"bad webhook","request_headers":"***"}`, trace["response_body"])
+	assert.Equal(t, "***", trace["request_headers"].(map[string]interface{})["Authorization"])
+}
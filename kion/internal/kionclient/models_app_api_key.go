@@ -0,0 +1,38 @@
+package kionclient
+
+// AppAPIKey is an app API key as listed by Kion. The secret is only returned
+// when the key is created.
+type AppAPIKey struct {
+	CreatedAt string `json:"created_at"`
+	Expiry    string `json:"expiry"`
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	UserID    int    `json:"user_id"`
+}
+
+// AppAPIKeyListResponse for: GET /api/v3/app-api-key
+type AppAPIKeyListResponse struct {
+	Data   []AppAPIKey `json:"data"`
+	Status int         `json:"status"`
+}
+
+// AppAPIKeyResponse for: GET /api/v3/app-api-key/{id}
+type AppAPIKeyResponse struct {
+	Data   AppAPIKey `json:"data"`
+	Status int       `json:"status"`
+}
+
+// AppAPIKeyCreate for: POST /api/v3/app-api-key
+type AppAPIKeyCreate struct {
+	Name   string `json:"name"`
+	UserID int    `json:"user_id,omitempty"`
+}
+
+// AppAPIKeyCreateResponse for: POST /api/v3/app-api-key
+type AppAPIKeyCreateResponse struct {
+	Data struct {
+		ID  int    `json:"id"`
+		Key string `json:"key"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
@@ -0,0 +1,24 @@
+package kionclient
+
+// LoginRequest for: POST /api/v3/login
+type LoginRequest struct {
+	IDMS     int    `json:"idms"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// LoginResponse for: POST /api/v3/login
+type LoginResponse struct {
+	Data struct {
+		Access struct {
+			Expiry string `json:"expiry"`
+			Token  string `json:"token"`
+		} `json:"access"`
+		Refresh struct {
+			Expiry string `json:"expiry"`
+			Token  string `json:"token"`
+		} `json:"refresh"`
+		UserID int `json:"user_id"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
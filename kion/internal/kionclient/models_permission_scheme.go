@@ -0,0 +1,49 @@
+package kionclient
+
+// PermissionSchemeTypes are the kinds of objects a permission scheme applies
+// to.
+var PermissionSchemeTypes = []string{"cloud-rule", "funding-source", "ou", "project"}
+
+// PermissionScheme is a permission scheme as returned by Kion.
+type PermissionScheme struct {
+	Description string                  `json:"description"`
+	Grants      []PermissionSchemeGrant `json:"grants"`
+	ID          int                     `json:"id"`
+	Name        string                  `json:"name"`
+	SchemeType  string                  `json:"scheme_type"`
+	System      bool                    `json:"system"`
+}
+
+// PermissionSchemeGrant grants the permissions to the users and groups that
+// hold an app role on an object with the scheme.
+type PermissionSchemeGrant struct {
+	AppRoleID     int   `json:"app_role_id"`
+	PermissionIDs []int `json:"permission_ids"`
+}
+
+// PermissionSchemeListResponse for: GET /api/v3/permission-scheme
+type PermissionSchemeListResponse struct {
+	Data   []PermissionScheme `json:"data"`
+	Status int                `json:"status"`
+}
+
+// PermissionSchemeResponse for: GET /api/v3/permission-scheme/{id}
+type PermissionSchemeResponse struct {
+	Data   PermissionScheme `json:"data"`
+	Status int              `json:"status"`
+}
+
+// PermissionSchemeCreate for: POST /api/v3/permission-scheme
+type PermissionSchemeCreate struct {
+	Description string                  `json:"description"`
+	Grants      []PermissionSchemeGrant `json:"grants"`
+	Name        string                  `json:"name"`
+	SchemeType  string                  `json:"scheme_type"`
+}
+
+// PermissionSchemeUpdatable for: PATCH /api/v3/permission-scheme/{id}
+type PermissionSchemeUpdatable struct {
+	Description string                  `json:"description"`
+	Grants      []PermissionSchemeGrant `json:"grants"`
+	Name        string                  `json:"name"`
+}
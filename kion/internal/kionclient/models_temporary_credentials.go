@@ -0,0 +1,40 @@
+package kionclient
+
+import "context"
+
+// TemporaryCredentialsRequest for: POST /api/v3/temporary-credentials
+type TemporaryCredentialsRequest struct {
+	AccountNumber string `json:"account_number"`
+	IAMRoleName   string `json:"iam_role_name"`
+}
+
+// TemporaryCredentialsResponse for: POST /api/v3/temporary-credentials
+type TemporaryCredentialsResponse struct {
+	Data   TemporaryCredentials `json:"data"`
+	Status int                  `json:"status"`
+}
+
+// TemporaryCredentials are short-lived AWS credentials of a cloud access role.
+type TemporaryCredentials struct {
+	AccessKeyID     string `json:"access_key"`
+	Expiration      string `json:"expiration"`
+	SecretAccessKey string `json:"secret_access_key"`
+	SessionToken    string `json:"session_token"`
+}
+
+// GetTemporaryCredentials returns temporary credentials for the cloud access
+// role with the IAM role roleName in the account. Kion checks that the user
+// the client authenticates as holds the role.
+func GetTemporaryCredentials(ctx context.Context, client *Client, accountNumber, roleName string) (*TemporaryCredentials, error) {
+	req := TemporaryCredentialsRequest{
+		AccountNumber: accountNumber,
+		IAMRoleName:   roleName,
+	}
+
+	// Requesting credentials has no side effects, so it is safe to retry.
+	resp := new(TemporaryCredentialsResponse)
+	if err := client.PostWithResponseContext(ctx, "/v3/temporary-credentials", req, resp, WithRetry(true)); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
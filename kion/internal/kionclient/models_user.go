@@ -1,21 +1,58 @@
 package kionclient
 
+// User is the profile of a Kion user as returned by the user endpoints.
+type User struct {
+	CreatedAt string `json:"created_at"`
+	Email     string `json:"email"`
+	Enabled   bool   `json:"enabled"`
+	FirstName string `json:"first_name"`
+	ID        int    `json:"id"`
+	IdmsID    int    `json:"idms_id"`
+	LastLogin string `json:"last_login"`
+	LastName  string `json:"last_name"`
+	Phone     string `json:"phone"`
+	Username  string `json:"username"`
+}
+
 // UserListResponse for: GET /api/v3/user
 type UserListResponse struct {
-	Data []struct {
-		ID       int    `json:"id"`
-		Username string `json:"username"`
-		Enabled  bool   `json:"enabled"`
-	} `json:"data"`
-	Status int `json:"status"`
+	Data   []User `json:"data"`
+	Status int    `json:"status"`
 }
 
 // UserResponse for: GET /api/v3/user/{id}
 type UserResponse struct {
 	Data struct {
-		User struct {
-			ID int `json:"id"`
-		} `json:"user"`
+		User User `json:"user"`
 	} `json:"data"`
 	Status int `json:"status"`
 }
+
+// UserCreate for: POST /api/v3/user
+type UserCreate struct {
+	Email                string `json:"email"`
+	Enabled              bool   `json:"enabled"`
+	FirstName            string `json:"first_name"`
+	IdmsID               int    `json:"idms_id"`
+	LastName             string `json:"last_name"`
+	Password             string `json:"password,omitempty"`
+	Phone                string `json:"phone"`
+	RequirePasswordReset bool   `json:"require_password_reset"`
+	Username             string `json:"username"`
+}
+
+// UserUpdatable for: PATCH /api/v3/user/{id}
+type UserUpdatable struct {
+	Email     string `json:"email"`
+	Enabled   bool   `json:"enabled"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Phone     string `json:"phone"`
+	Username  string `json:"username"`
+}
+
+// UserPassword for: PUT /api/v3/user/{id}/password
+type UserPassword struct {
+	Password             string `json:"password"`
+	RequirePasswordReset bool   `json:"require_password_reset"`
+}
@@ -0,0 +1,164 @@
+package kionclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"maps"
+	"strconv"
+)
+
+// listEnvelope is the outer shape of every Kion list response. Data is either
+// a plain array (unpaginated v3 endpoints) or a page object.
+type listEnvelope struct {
+	Data json.RawMessage `json:"data"`
+}
+
+// listPage is a page object as returned by paginated v3 endpoints
+// ({"items": [...], "total": n}) and by v4 endpoints, which add a
+// "pagination" object describing the page that was returned.
+type listPage[T any] struct {
+	Items      []T  `json:"items"`
+	Total      *int `json:"total"`
+	Pagination struct {
+		Count int `json:"count"`
+		Page  int `json:"page"`
+	} `json:"pagination"`
+}
+
+// List fetches every page of a Kion list endpoint and returns all items.
+// See Iterate for how pages are requested.
+func List[T any](ctx context.Context, client *Client, urlPath string, params map[string]string, opts ...RequestOption) ([]T, error) {
+	items := make([]T, 0)
+	for item, err := range Iterate[T](ctx, client, urlPath, params, opts...) {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// ListInto is like List but stores the items in dst. It lets callers keep
+// decoding into the element type of an existing list response model, e.g.
+// ListInto(ctx, client, "/v3/ou", nil, &resp.Data).
+func ListInto[T any](ctx context.Context, client *Client, urlPath string, params map[string]string, dst *[]T, opts ...RequestOption) error {
+	items, err := List[T](ctx, client, urlPath, params, opts...)
+	if err != nil {
+		return err
+	}
+	*dst = items
+	return nil
+}
+
+// Iterate returns an iterator over every item of a Kion list endpoint,
+// fetching pages lazily as the caller advances.
+//
+// Endpoints that return a plain array are fetched once. For paginated v3 and
+// v4 endpoints the first page uses the server's default page size and the
+// following pages are requested with the same size until "total" items have
+// been returned or a short page is received. If params already contains a
+// "page" key only that page is fetched.
+func Iterate[T any](ctx context.Context, client *Client, urlPath string, params map[string]string, opts ...RequestOption) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		query := maps.Clone(params)
+		if query == nil {
+			query = make(map[string]string)
+		}
+		_, singlePage := query["page"]
+
+		page := 1
+		pageSize := 0
+		fetched := 0
+		var previous []byte
+		for {
+			env := new(listEnvelope)
+			if err := client.GETWithParamsContext(ctx, urlPath, query, env, opts...); err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			items, total, count, err := decodeListData[T](env.Data)
+			if err != nil {
+				var zero T
+				yield(zero, fmt.Errorf("could not decode list response from %s: %w", urlPath, err))
+				return
+			}
+
+			// Guard against endpoints that ignore the page parameter, which
+			// would otherwise be fetched forever.
+			if previous != nil && bytes.Equal(previous, env.Data) {
+				var zero T
+				yield(zero, fmt.Errorf("list endpoint %s returned the same data for page %d and %d", urlPath, page-1, page))
+				return
+			}
+			previous = env.Data
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			fetched += len(items)
+
+			// Plain arrays are never paginated.
+			if total == totalUnpaginated || singlePage {
+				return
+			}
+
+			if pageSize == 0 {
+				pageSize = count
+				if pageSize == 0 {
+					pageSize = len(items)
+				}
+			}
+
+			if len(items) == 0 || len(items) < pageSize || (total != totalUnknown && fetched >= total) {
+				return
+			}
+
+			page++
+			query["page"] = strconv.Itoa(page)
+			query["count"] = strconv.Itoa(pageSize)
+		}
+	}
+}
+
+// Special totals returned by decodeListData.
+const (
+	totalUnpaginated = -1 // the endpoint returned a plain array
+	totalUnknown     = -2 // the page object did not include a total
+)
+
+// decodeListData decodes the "data" member of a list response. It returns the
+// items, the total number of items across all pages (or one of the special
+// totals above) and the page size reported by the server, which is zero when
+// the response does not include one.
+func decodeListData[T any](data json.RawMessage) ([]T, int, int, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil, totalUnpaginated, 0, nil
+	}
+
+	if trimmed[0] == '[' {
+		var items []T
+		if err := json.Unmarshal(trimmed, &items); err != nil {
+			return nil, 0, 0, err
+		}
+		return items, totalUnpaginated, 0, nil
+	}
+
+	var page listPage[T]
+	if err := json.Unmarshal(trimmed, &page); err != nil {
+		return nil, 0, 0, err
+	}
+
+	total := totalUnknown
+	if page.Total != nil {
+		total = *page.Total
+	}
+	return page.Items, total, page.Pagination.Count, nil
+}
@@ -0,0 +1,117 @@
+package kionclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testItem struct {
+	ID int `json:"id"`
+}
+
+// newPagedServer serves total items in pages of defaultCount, honoring the
+// page and count query parameters. body renders one page.
+func newPagedServer(t *testing.T, total, defaultCount int, body func(items string, page, count int) string) (*httptest.Server, *[]string) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+
+		page, count := 1, defaultCount
+		if v := r.URL.Query().Get("page"); v != "" {
+			page, _ = strconv.Atoi(v)
+		}
+		if v := r.URL.Query().Get("count"); v != "" {
+			count, _ = strconv.Atoi(v)
+		}
+
+		ids := make([]string, 0, count)
+		for id := (page-1)*count + 1; id <= total && id <= page*count; id++ {
+			ids = append(ids, fmt.Sprintf(`{"id":%d}`, id))
+		}
+		_, _ = w.Write([]byte(body("["+strings.Join(ids, ",")+"]", page, count)))
+	}))
+	t.Cleanup(server.Close)
+	return server, &queries
+}
+
+func TestListV3Envelope(t *testing.T) {
+	server, queries := newPagedServer(t, 250, 100, func(items string, _, _ int) string {
+		return fmt.Sprintf(`{"data":{"items":%s,"total":250},"status":200}`, items)
+	})
+
+	client := newTestClient(t, server.URL)
+	items, err := List[testItem](context.Background(), client, "/v3/label", nil)
+	assert.NoError(t, err)
+	assert.Len(t, items, 250)
+	assert.Equal(t, 250, items[249].ID)
+	assert.Equal(t, []string{"", "count=100&page=2", "count=100&page=3"}, *queries)
+}
+
+func TestListV4Envelope(t *testing.T) {
+	server, queries := newPagedServer(t, 120, 50, func(items string, page, count int) string {
+		return fmt.Sprintf(`{"data":{"items":%s,"pagination":{"page":%d,"count":%d},"total":120},"status":200}`, items, page, count)
+	})
+
+	client := newTestClient(t, server.URL)
+	items, err := List[testItem](context.Background(), client, "/v4/iam-policy", map[string]string{"query": "Admin"})
+	assert.NoError(t, err)
+	assert.Len(t, items, 120)
+	assert.Len(t, *queries, 3)
+	assert.Equal(t, "count=50&page=3&query=Admin", (*queries)[2])
+}
+
+func TestListWithoutTotal(t *testing.T) {
+	server, queries := newPagedServer(t, 30, 20, func(items string, _, _ int) string {
+		return fmt.Sprintf(`{"data":{"items":%s},"status":200}`, items)
+	})
+
+	client := newTestClient(t, server.URL)
+	items, err := List[testItem](context.Background(), client, "/v3/custom-variable", nil)
+	assert.NoError(t, err)
+	assert.Len(t, items, 30)
+	assert.Len(t, *queries, 2)
+}
+
+func TestListPlainArray(t *testing.T) {
+	server, queries := newPagedServer(t, 5, 5, func(items string, _, _ int) string {
+		return fmt.Sprintf(`{"data":%s,"status":200}`, items)
+	})
+
+	client := newTestClient(t, server.URL)
+	var dst []testItem
+	err := ListInto(context.Background(), client, "/v3/ou", nil, &dst)
+	assert.NoError(t, err)
+	assert.Len(t, dst, 5)
+	assert.Len(t, *queries, 1)
+}
+
+func TestListSinglePage(t *testing.T) {
+	server, queries := newPagedServer(t, 250, 100, func(items string, _, _ int) string {
+		return fmt.Sprintf(`{"data":{"items":%s,"total":250},"status":200}`, items)
+	})
+
+	client := newTestClient(t, server.URL)
+	items, err := List[testItem](context.Background(), client, "/v4/iam-policy", map[string]string{"page": "2", "count": "10"})
+	assert.NoError(t, err)
+	assert.Len(t, items, 10)
+	assert.Equal(t, 11, items[0].ID)
+	assert.Len(t, *queries, 1)
+}
+
+func TestListPageIgnored(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"items":[{"id":1},{"id":2}],"total":10},"status":200}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	_, err := List[testItem](context.Background(), client, "/v3/label", nil)
+	assert.ErrorContains(t, err, "returned the same data")
+}
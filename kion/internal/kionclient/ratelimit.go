@@ -0,0 +1,65 @@
+package kionclient
+
+import (
+	"context"
+	"math"
+
+	"golang.org/x/time/rate"
+)
+
+// requestLimiter throttles the requests sent by a Client. The token bucket
+// caps the request rate and the semaphore caps how many requests are in
+// flight at once. A nil limiter or semaphore means no limit.
+type requestLimiter struct {
+	limiter *rate.Limiter
+	slots   chan struct{}
+}
+
+// SetRateLimit limits the client to requestsPerSecond requests on average,
+// allowing bursts of up to burst requests. A rate of zero or less removes the
+// limit. Retries count against the limit like any other request.
+func (client *Client) SetRateLimit(requestsPerSecond float64, burst int) {
+	if requestsPerSecond <= 0 {
+		client.throttle.limiter = nil
+		return
+	}
+	if burst < 1 {
+		burst = int(math.Ceil(requestsPerSecond))
+	}
+	client.throttle.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+}
+
+// SetMaxConcurrentRequests limits how many requests the client sends at the
+// same time. A value of zero or less removes the limit.
+func (client *Client) SetMaxConcurrentRequests(maxConcurrent int) {
+	if maxConcurrent <= 0 {
+		client.throttle.slots = nil
+		return
+	}
+	client.throttle.slots = make(chan struct{}, maxConcurrent)
+}
+
+// acquire blocks until the request may be sent or ctx is done. On success the
+// returned release func must be called once the response has been read.
+func (l *requestLimiter) acquire(ctx context.Context) (func(), error) {
+	release := func() {}
+
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		slots := l.slots
+		release = func() { <-slots }
+	}
+
+	if l.limiter != nil {
+		if err := l.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	return release, nil
+}
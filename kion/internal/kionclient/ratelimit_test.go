@@ -0,0 +1,83 @@
+package kionclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxConcurrentRequests(t *testing.T) {
+	var inFlight, peak int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"status":200}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.SetMaxConcurrentRequests(2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, client.GET("/v3/ou", nil))
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&peak))
+}
+
+func TestRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":200}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.SetRateLimit(20, 1)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, client.GET("/v3/ou", nil))
+	}
+	// The first request uses the burst, the other four wait 50ms each.
+	assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+}
+
+func TestRateLimitHonorsContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":200}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.SetRateLimit(0.1, 1)
+	assert.NoError(t, client.GET("/v3/ou", nil))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := client.GETContext(ctx, "/v3/ou", nil)
+	assert.Error(t, err)
+
+	var reqErr *RequestError
+	assert.True(t, errors.As(err, &reqErr))
+}
@@ -0,0 +1,294 @@
+package kionclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Environment variables that put the clients of the acceptance tests in front
+// of a cassette. KION_RECORDER_MODE is "record" or "replay" and KION_CASSETTE
+// is the path of the cassette file.
+const (
+	RecorderModeEnv = "KION_RECORDER_MODE"
+	CassetteEnv     = "KION_CASSETTE"
+)
+
+// RecorderMode selects whether a Recorder talks to Kion or to its cassette.
+type RecorderMode string
+
+const (
+	// RecorderModeRecord sends requests to Kion and writes every interaction
+	// to the cassette, replacing what it held before.
+	RecorderModeRecord RecorderMode = "record"
+	// RecorderModeReplay answers requests from the cassette without any
+	// network access.
+	RecorderModeReplay RecorderMode = "replay"
+)
+
+// ErrNoInteraction is returned in replay mode for a request the cassette has
+// no unused interaction for.
+var ErrNoInteraction = errors.New("no recorded interaction")
+
+// Interaction is a request to Kion and its response as stored in a cassette.
+// Header values outside recordedHeaders and sensitive JSON fields are scrubbed
+// before they are stored, so cassettes can be committed.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the request half of an Interaction. URI is the path and
+// query without the host, so a cassette replays against any Kion URL with the
+// same apipath.
+type RecordedRequest struct {
+	Method  string            `json:"method"`
+	URI     string            `json:"uri"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+}
+
+// RecordedResponse is the response half of an Interaction.
+type RecordedResponse struct {
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+}
+
+// cassette holds the interactions of one file. It is shared by every Recorder
+// opened on the file in the process, as Terraform configures the provider, and
+// therefore creates a client, once per command of a test.
+type cassette struct {
+	path string
+
+	mu           sync.Mutex
+	Interactions []Interaction `json:"interactions"`
+	used         []bool
+}
+
+// recordedHeaders are the headers whose values a cassette keeps. The values of
+// every other header, such as Authorization, Cookie and Set-Cookie, are
+// replaced, so a header a cassette was never meant to hold cannot leak a
+// secret into it.
+var recordedHeaders = map[string]bool{
+	"Accept":           true,
+	"Content-Length":   true,
+	"Content-Type":     true,
+	"Date":             true,
+	"Retry-After":      true,
+	"User-Agent":       true,
+	"X-Request-Id":     true,
+	"X-Correlation-Id": true,
+}
+
+var (
+	cassettesMu sync.Mutex
+	cassettes   = make(map[string]*cassette)
+)
+
+// Recorder is an http.RoundTripper that records the traffic of a client to a
+// cassette file or replays it from one.
+type Recorder struct {
+	mode     RecorderMode
+	next     http.RoundTripper
+	cassette *cassette
+}
+
+// NewRecorder returns a Recorder for the cassette at path. In record mode
+// requests are sent through next, which defaults to http.DefaultTransport. In
+// replay mode the cassette must exist. Recorders for the same path share the
+// cassette, so a replay continues where the previous client stopped.
+func NewRecorder(path string, mode RecorderMode, next http.RoundTripper) (*Recorder, error) {
+	if mode != RecorderModeRecord && mode != RecorderModeReplay {
+		return nil, fmt.Errorf("invalid recorder mode %q: it must be %q or %q", mode, RecorderModeRecord, RecorderModeReplay)
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	c, err := openCassette(path, mode)
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{mode: mode, next: next, cassette: c}, nil
+}
+
+// RecordFromEnv puts the client in front of the cassette selected by
+// KION_RECORDER_MODE and KION_CASSETTE. It does nothing when
+// KION_RECORDER_MODE is not set. Only the provider of the acceptance tests
+// calls it; the provider itself ignores both variables.
+func (client *Client) RecordFromEnv() error {
+	mode := os.Getenv(RecorderModeEnv)
+	if mode == "" {
+		return nil
+	}
+	path := os.Getenv(CassetteEnv)
+	if path == "" {
+		return fmt.Errorf("%s must be set when %s is %q", CassetteEnv, RecorderModeEnv, mode)
+	}
+
+	recorder, err := NewRecorder(path, RecorderMode(mode), client.HTTPClient.Transport)
+	if err != nil {
+		return err
+	}
+	client.HTTPClient.Transport = recorder
+	return nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded := RecordedRequest{
+		Method:  req.Method,
+		URI:     req.URL.RequestURI(),
+		Headers: recordHeaders(req.Header),
+	}
+	if body := requestBody(req); len(body) > 0 {
+		recorded.Body = redactBody(body)
+	}
+
+	if r.mode == RecorderModeReplay {
+		interaction, ok := r.cassette.next(recorded)
+		if !ok {
+			return nil, fmt.Errorf("%w for %s %s in %s", ErrNoInteraction, req.Method, recorded.URI, r.cassette.path)
+		}
+		return interaction.Response.httpResponse(req), nil
+	}
+
+	res, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	response := RecordedResponse{
+		Status:  res.StatusCode,
+		Headers: recordHeaders(res.Header),
+	}
+	if len(body) > 0 {
+		response.Body = redactBody(body)
+	}
+	if err := r.cassette.append(Interaction{Request: recorded, Response: response}); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// recordHeaders flattens headers for a cassette and replaces the values of the
+// headers that are not in recordedHeaders.
+func recordHeaders(headers http.Header) map[string]string {
+	flat := make(map[string]string, len(headers))
+	for key, values := range headers {
+		if !recordedHeaders[http.CanonicalHeaderKey(key)] {
+			flat[key] = redacted
+			continue
+		}
+		flat[key] = strings.Join(values, ", ")
+	}
+	return flat
+}
+
+// openCassette returns the shared cassette for path, loading it in replay
+// mode and starting it empty in record mode.
+func openCassette(path string, mode RecorderMode) (*cassette, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cassettesMu.Lock()
+	defer cassettesMu.Unlock()
+
+	key := string(mode) + ":" + abs
+	if c, ok := cassettes[key]; ok {
+		return c, nil
+	}
+
+	c := &cassette{path: abs}
+	if mode == RecorderModeReplay {
+		data, err := os.ReadFile(abs)
+		if err != nil {
+			return nil, fmt.Errorf("reading cassette: %w", err)
+		}
+		if err := json.Unmarshal(data, c); err != nil {
+			return nil, fmt.Errorf("decoding cassette %s: %w", abs, err)
+		}
+		c.used = make([]bool, len(c.Interactions))
+	}
+	cassettes[key] = c
+	return c, nil
+}
+
+// next returns the first unused interaction that matches the method, URI and
+// body of req and marks it used. Interactions are consumed in order, so the
+// same GET may return different responses before and after an update.
+func (c *cassette) next(req RecordedRequest) (Interaction, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, interaction := range c.Interactions {
+		recorded := interaction.Request
+		if c.used[i] || recorded.Method != req.Method || recorded.URI != req.URI || recorded.Body != req.Body {
+			continue
+		}
+		c.used[i] = true
+		return interaction, true
+	}
+	return Interaction{}, false
+}
+
+// append adds interaction to the cassette and rewrites the file. Writing after
+// every request keeps the cassette complete without a hook at the end of the
+// test.
+func (c *cassette) append(interaction Interaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.Interactions = append(c.Interactions, interaction)
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return fmt.Errorf("writing cassette: %w", err)
+	}
+
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("writing cassette: %w", err)
+	}
+	if err := os.Rename(tmp, c.path); err != nil {
+		return fmt.Errorf("writing cassette: %w", err)
+	}
+	return nil
+}
+
+// httpResponse builds the response replayed for req.
+func (r RecordedResponse) httpResponse(req *http.Request) *http.Response {
+	header := make(http.Header, len(r.Headers))
+	for key, value := range r.Headers {
+		header.Set(key, value)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.Status, http.StatusText(r.Status)),
+		StatusCode:    r.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
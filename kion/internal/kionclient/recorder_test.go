@@ -0,0 +1,143 @@
+package kionclient
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	var name string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			name = "updated"
+			_, _ = w.Write([]byte(`{"status":200}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"id":1,"name":"` + name + `","smtp_password":"hunter2"},"status":200}`))
+	}))
+	name = "initial"
+
+	path := filepath.Join(t.TempDir(), "cassettes", "webhook.json")
+	client := newTestClient(t, server.URL)
+	client.Token = "app_1_secret"
+	recorder, err := NewRecorder(path, RecorderModeRecord, client.HTTPClient.Transport)
+	require.NoError(t, err)
+	client.HTTPClient.Transport = recorder
+
+	var resp struct {
+		Data struct {
+			Name string `json:"name"`
+		} `json:"data"`
+	}
+	require.NoError(t, client.GET("/v3/webhook/1", &resp))
+	assert.Equal(t, "initial", resp.Data.Name)
+	require.NoError(t, client.PATCH("/v3/webhook/1", map[string]string{"name": "updated", "smtp_password": "hunter2"}))
+	require.NoError(t, client.GET("/v3/webhook/1", &resp))
+	server.Close()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "hunter2")
+	assert.NotContains(t, string(data), "app_1_secret")
+
+	// The replay needs no server and returns the responses in recorded order.
+	client = newTestClient(t, "https://kion.invalid")
+	recorder, err = NewRecorder(path, RecorderModeReplay, nil)
+	require.NoError(t, err)
+	client.HTTPClient.Transport = recorder
+
+	require.NoError(t, client.GET("/v3/webhook/1", &resp))
+	assert.Equal(t, "initial", resp.Data.Name)
+	require.NoError(t, client.PATCH("/v3/webhook/1", map[string]string{"name": "updated", "smtp_password": "other"}))
+	require.NoError(t, client.GET("/v3/webhook/1", &resp))
+	assert.Equal(t, "updated", resp.Data.Name)
+
+	err = client.GET("/v3/webhook/1", &resp)
+	assert.ErrorIs(t, err, ErrNoInteraction)
+	err = client.PATCH("/v3/webhook/1", map[string]string{"name": "other"})
+	assert.ErrorIs(t, err, ErrNoInteraction)
+}
+
+func TestRecorderReplaysErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	path := filepath.Join(t.TempDir(), "missing.json")
+	client := newTestClient(t, server.URL)
+	recorder, err := NewRecorder(path, RecorderModeRecord, client.HTTPClient.Transport)
+	require.NoError(t, err)
+	client.HTTPClient.Transport = recorder
+	assert.ErrorIs(t, client.GET("/v3/ou/9", nil), ErrNotFound)
+	server.Close()
+
+	recorder, err = NewRecorder(path, RecorderModeReplay, nil)
+	require.NoError(t, err)
+	client.HTTPClient.Transport = recorder
+	assert.ErrorIs(t, client.GET("/v3/ou/9", nil), ErrNotFound)
+}
+
+func TestRecorderRedactsHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "s3cr3t"})
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-1")
+		_, _ = w.Write([]byte(`{"status":200}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cookies.json")
+	client := newTestClient(t, server.URL)
+	recorder, err := NewRecorder(path, RecorderModeRecord, client.HTTPClient.Transport)
+	require.NoError(t, err)
+	client.HTTPClient.Transport = recorder
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/v3/ou/1", nil)
+	require.NoError(t, err)
+	req.Header.Set("Cookie", "session=s3cr3t")
+	res, err := client.HTTPClient.Do(req)
+	require.NoError(t, err)
+	res.Body.Close()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "s3cr3t")
+
+	var c cassette
+	require.NoError(t, json.Unmarshal(data, &c))
+	require.Len(t, c.Interactions, 1)
+	interaction := c.Interactions[0]
+	assert.Equal(t, redacted, interaction.Request.Headers["Cookie"])
+	assert.Equal(t, redacted, interaction.Response.Headers["Set-Cookie"])
+	assert.Equal(t, "req-1", interaction.Response.Headers["X-Request-Id"])
+	assert.Equal(t, "application/json", interaction.Response.Headers["Content-Type"])
+}
+
+func TestRecordFromEnv(t *testing.T) {
+	client := newTestClient(t, "https://kion.invalid")
+	transport := client.HTTPClient.Transport
+
+	t.Setenv(RecorderModeEnv, "")
+	assert.NoError(t, client.RecordFromEnv())
+	assert.Equal(t, transport, client.HTTPClient.Transport)
+
+	t.Setenv(RecorderModeEnv, "replay")
+	assert.ErrorContains(t, client.RecordFromEnv(), CassetteEnv)
+
+	t.Setenv(CassetteEnv, filepath.Join(t.TempDir(), "absent.json"))
+	assert.ErrorContains(t, client.RecordFromEnv(), "reading cassette")
+
+	t.Setenv(RecorderModeEnv, "rewind")
+	assert.ErrorContains(t, client.RecordFromEnv(), "invalid recorder mode")
+
+	t.Setenv(RecorderModeEnv, "record")
+	require.NoError(t, client.RecordFromEnv())
+	assert.IsType(t, &Recorder{}, client.HTTPClient.Transport)
+}
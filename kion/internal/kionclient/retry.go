@@ -0,0 +1,244 @@
+package kionclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a failed request is retried when
+	// the client has not been configured otherwise.
+	DefaultMaxRetries = 3
+
+	// DefaultRetryWaitMin is the backoff used before the first retry.
+	DefaultRetryWaitMin = 1 * time.Second
+
+	// DefaultRetryMaxWait caps the backoff between two attempts, including waits
+	// requested by the server through the Retry-After header.
+	DefaultRetryMaxWait = 30 * time.Second
+)
+
+// retryableStatusCodes are the HTTP status codes that indicate a transient
+// condition on the Kion side (rate limiting or an unavailable upstream).
+var retryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+// idempotentMethods are retried by default. Other methods (POST, PATCH) are
+// only retried when the caller opts in with WithRetry(true).
+var idempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+}
+
+// RequestOption customizes the behavior of a single client call.
+type RequestOption func(*requestOptions)
+
+type requestOptions struct {
+	retry      *bool
+	maxRetries *int
+
+	// noAuth sends the request without an Authorization header, which is
+	// used to log in.
+	noAuth bool
+	// noCache bypasses the response cache, see WithoutCache.
+	noCache bool
+}
+
+// WithRetry forces retries on or off for a single request, overriding the
+// default that only idempotent methods are retried.
+func WithRetry(enabled bool) RequestOption {
+	return func(o *requestOptions) {
+		o.retry = &enabled
+	}
+}
+
+// WithMaxRetries overrides the client's MaxRetries for a single request.
+func WithMaxRetries(maxRetries int) RequestOption {
+	return func(o *requestOptions) {
+		o.maxRetries = &maxRetries
+	}
+}
+
+func newRequestOptions(opts []RequestOption) *requestOptions {
+	o := &requestOptions{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(o)
+		}
+	}
+	return o
+}
+
+// attempts returns how many times a request using the given method may be sent.
+func (client *Client) attempts(method string, o *requestOptions) int {
+	retry := idempotentMethods[method]
+	if o.retry != nil {
+		retry = *o.retry
+	}
+	if !retry {
+		return 1
+	}
+
+	maxRetries := client.MaxRetries
+	if o.maxRetries != nil {
+		maxRetries = *o.maxRetries
+	}
+	if maxRetries < 0 {
+		maxRetries = 0
+	}
+	return maxRetries + 1
+}
+
+// doRequestWithRetry sends the request, retrying transient failures with
+// exponential backoff until the attempts are exhausted or the request context
+// is done. The request body is rewound through req.GetBody between attempts.
+//
+// When Kion rejects a token obtained from the client's TokenSource, the token
+// is invalidated and the request is sent once more with a new one.
+func (client *Client) doRequestWithRetry(req *http.Request, o *requestOptions) ([]byte, int, error) {
+	ctx := req.Context()
+	maxAttempts := client.attempts(req.Method, o)
+	reauthenticated := false
+	sent := false
+
+	for attempt := 1; ; attempt++ {
+		if sent && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, 0, NewRequestError(0, err)
+			}
+			req.Body = body
+		}
+
+		token, err := client.authorize(req, o)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		body, statusCode, retryAfter, err := client.doRequest(req)
+		sent = true
+		if statusCode == http.StatusUnauthorized && client.TokenSource != nil && !o.noAuth && !reauthenticated {
+			// A 401 means the request was not processed, so it is safe to
+			// resend it whatever its method. It does not use up an attempt.
+			reauthenticated = true
+			client.TokenSource.Invalidate(token)
+			attempt--
+			continue
+		}
+		if err == nil || attempt >= maxAttempts || !isRetryable(ctx, statusCode, err) {
+			return body, statusCode, err
+		}
+
+		wait := client.backoff(attempt, retryAfter)
+		tflog.Warn(ctx, "Retrying Kion API request after transient failure", map[string]interface{}{
+			"method":       req.Method,
+			"url":          req.URL.String(),
+			"status":       statusCode,
+			"attempt":      attempt,
+			"max_attempts": maxAttempts,
+			"wait":         wait.String(),
+			"error":        err.Error(),
+		})
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, statusCode, NewRequestError(statusCode, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// isRetryable reports whether a failed attempt is worth repeating.
+func isRetryable(ctx context.Context, statusCode int, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if retryableStatusCodes[statusCode] {
+		return true
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// backoff returns how long to wait before the next attempt. A server supplied
+// Retry-After wins over the computed exponential delay; both are capped at
+// RetryMaxWait.
+func (client *Client) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	maxWait := client.RetryMaxWait
+	if maxWait <= 0 {
+		maxWait = DefaultRetryMaxWait
+	}
+	minWait := client.RetryWaitMin
+	if minWait <= 0 {
+		minWait = DefaultRetryWaitMin
+	}
+	if minWait > maxWait {
+		minWait = maxWait
+	}
+
+	if retryAfter > 0 {
+		if retryAfter > maxWait {
+			return maxWait
+		}
+		return retryAfter
+	}
+
+	wait := time.Duration(float64(minWait) * math.Pow(2, float64(attempt-1)))
+	if wait <= 0 || wait > maxWait {
+		wait = maxWait
+	}
+
+	// Use "equal jitter" so concurrent retries spread out without collapsing to zero.
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// parseRetryAfter interprets a Retry-After header given either in seconds or
+// as an HTTP date. It returns zero when the header is missing or invalid.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
@@ -0,0 +1,124 @@
+package kionclient
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newRetryTestClient returns a client pointed at the test server with short backoffs.
+func newRetryTestClient(t *testing.T, url string) *Client {
+	client := newTestClient(t, url)
+	client.RetryWaitMin = time.Millisecond
+	client.RetryMaxWait = 5 * time.Millisecond
+	return client
+}
+
+func TestRetryTransientStatus(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":200}`))
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(t, server.URL)
+	err := client.GET("/v3/ou", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(t, server.URL)
+	client.MaxRetries = 2
+	err := client.GET("/v3/ou", nil)
+	assert.Error(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+
+	// The per-request override wins over the client setting.
+	atomic.StoreInt32(&calls, 0)
+	err = client.GET("/v3/ou", nil, WithMaxRetries(0))
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRetryNonRetryableStatus(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(t, server.URL)
+	err := client.GET("/v3/ou", nil)
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRetryPostOnlyWhenRequested(t *testing.T) {
+	var calls int32
+	var lastBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf, _ := io.ReadAll(r.Body)
+		lastBody = string(buf)
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"record_id":1,"status":201}`))
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(t, server.URL)
+	_, err := client.POST("/v3/ou", map[string]string{"name": "test"})
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	// The body must be replayed on the second attempt.
+	resp, err := client.POST("/v3/ou", map[string]string{"name": "test"}, WithRetry(true))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, resp.RecordID)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	assert.Equal(t, `{"name":"test"}`, lastBody)
+}
+
+func TestBackoff(t *testing.T) {
+	client := &Client{RetryWaitMin: time.Second, RetryMaxWait: 10 * time.Second}
+
+	for attempt := 1; attempt <= 6; attempt++ {
+		wait := client.backoff(attempt, 0)
+		assert.LessOrEqual(t, wait, 10*time.Second)
+		assert.GreaterOrEqual(t, wait, time.Second/2)
+	}
+
+	// Retry-After is honored but capped.
+	assert.Equal(t, 3*time.Second, client.backoff(1, 3*time.Second))
+	assert.Equal(t, 10*time.Second, client.backoff(1, time.Minute))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	assert.Equal(t, time.Duration(0), parseRetryAfter(""))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("soon"))
+	assert.Equal(t, 5*time.Second, parseRetryAfter("5"))
+
+	future := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	d := parseRetryAfter(future)
+	assert.Greater(t, d, 50*time.Second)
+	assert.LessOrEqual(t, d, time.Minute)
+}
@@ -0,0 +1,66 @@
+package kionclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Errors returned by NewClient when a ClientOption cannot be applied.
+var (
+	ErrInvalidCACert     = errors.New("kion: invalid CA certificate")
+	ErrInvalidClientCert = errors.New("kion: invalid client certificate")
+	ErrInvalidProxyURL   = errors.New("kion: invalid proxy URL")
+)
+
+// ClientOption customizes the HTTP transport of a Client created by NewClient.
+type ClientOption func(*http.Transport) error
+
+// WithCACertPEM trusts the PEM encoded CA certificates in addition to the
+// system roots when verifying the Kion server certificate.
+func WithCACertPEM(pem []byte) ClientOption {
+	return func(t *http.Transport) error {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("%w: no PEM encoded certificates found", ErrInvalidCACert)
+		}
+		t.TLSClientConfig.RootCAs = pool
+		return nil
+	}
+}
+
+// WithClientCertificate presents the PEM encoded certificate and private key
+// to servers and proxies that require mutual TLS.
+func WithClientCertificate(certPEM, keyPEM []byte) ClientOption {
+	return func(t *http.Transport) error {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidClientCert, err)
+		}
+		t.TLSClientConfig.Certificates = append(t.TLSClientConfig.Certificates, cert)
+		return nil
+	}
+}
+
+// WithProxyURL sends every request through the given HTTP or HTTPS proxy
+// instead of the proxy configured through the environment (HTTPS_PROXY,
+// NO_PROXY).
+func WithProxyURL(proxyURL string) ClientOption {
+	return func(t *http.Transport) error {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return fmt.Errorf("%w %q: %v", ErrInvalidProxyURL, proxyURL, err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w %q: it must be an absolute http or https URL such as http://proxy.example.com:3128", ErrInvalidProxyURL, proxyURL)
+		}
+		t.Proxy = http.ProxyURL(u)
+		return nil
+	}
+}
@@ -0,0 +1,51 @@
+package kionclient
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithCACertPEM(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":200}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.MaxRetries = 0
+	assert.Error(t, client.GET("/v3/ou", nil))
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	client, err := NewClient(server.URL, "test-key", "", false, WithCACertPEM(caPEM))
+	assert.NoError(t, err)
+	assert.NoError(t, client.GET("/v3/ou", nil))
+
+	_, err = NewClient(server.URL, "test-key", "", false, WithCACertPEM([]byte("not a certificate")))
+	assert.ErrorIs(t, err, ErrInvalidCACert)
+}
+
+func TestWithClientCertificateInvalid(t *testing.T) {
+	_, err := NewClient("https://kion.example.com", "test-key", "", false, WithClientCertificate([]byte("cert"), []byte("key")))
+	assert.ErrorIs(t, err, ErrInvalidClientCert)
+}
+
+func TestWithProxyURL(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		_, _ = w.Write([]byte(`{"status":200}`))
+	}))
+	defer proxy.Close()
+
+	client, err := NewClient("http://kion.example.com", "test-key", "/api", false, WithProxyURL(proxy.URL))
+	assert.NoError(t, err)
+	assert.NoError(t, client.GET("/v3/ou", nil))
+	assert.Equal(t, "http://kion.example.com/api/v3/ou", proxied)
+
+	_, err = NewClient("http://kion.example.com", "test-key", "/api", false, WithProxyURL("proxy.example.com:3128"))
+	assert.ErrorIs(t, err, ErrInvalidProxyURL)
+}
@@ -0,0 +1,661 @@
+// Package kionfake provides an in-memory Kion API for unit tests. It follows
+// the conventions the provider relies on: POST to a collection creates an
+// object and returns its record_id, GET, PATCH, PUT and DELETE address an
+// object by ID, and a missing object is a 404. The API version and the
+// "/api" prefix are ignored, so /v2/ou/5 and /api/v3/ou/5 are the same object.
+//
+// Endpoints with more behavior than that, such as creating a project with
+// its funding or an account through the account cache, are served by
+// handlers a test registers with Handle.
+package kionfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Object is a stored Kion object as it was sent in the create request, plus
+// its "id".
+type Object = map[string]interface{}
+
+// Collection describes how a Kion collection shapes its responses.
+type Collection struct {
+	// Path is the collection path after the API version, e.g. "ou" or
+	// "compliance/check".
+	Path string
+	// Wrap nests the object under this key in GET by ID responses, next to
+	// its Related lists. The object itself is returned when empty.
+	Wrap string
+	// Related maps a key of the GET by ID response to the stored field
+	// holding the IDs it lists, e.g. "owner_users" to "owner_user_ids".
+	Related map[string]string
+	// Lifted are stored fields returned next to the wrapped object instead
+	// of inside it, e.g. the tags of a CloudFormation template.
+	Lifted []string
+	// Paged returns the collection as {"items": [...], "total": n}.
+	Paged bool
+	// Renamed maps a request field to the name Kion returns it under.
+	Renamed map[string]string
+	// WrappedCreate means create requests are shaped like GET by ID
+	// responses: the object under Wrap and its Related lists next to it.
+	WrappedCreate bool
+	// Singleton means the collection path addresses a single settings
+	// object, e.g. the app config, which GET reads and PATCH and PUT update.
+	// It is stored under ID 0 and starts out empty.
+	Singleton bool
+}
+
+// owners are the related lists of most collections.
+var owners = map[string]string{
+	"owner_user_groups": "owner_user_group_ids",
+	"owner_users":       "owner_user_ids",
+}
+
+// accountNumbers are the request fields of Azure and Google Cloud accounts
+// that Kion returns as the account_number.
+var accountNumbers = map[string]string{
+	"google_cloud_project_id": "account_number",
+	"subscription_uuid":       "account_number",
+}
+
+// DefaultCollections are the collections the provider manages.
+var DefaultCollections = []Collection{
+	{Path: "account", Renamed: accountNumbers},
+	{Path: "account-cache", Renamed: accountNumbers},
+	{Path: "app-api-key"},
+	{Path: "app-config", Singleton: true},
+	{Path: "azure-arm-template", Wrap: "azure_arm_template", Related: owners},
+	{Path: "azure-policy", Wrap: "azure_policy", Related: owners, WrappedCreate: true},
+	{Path: "azure-role", Wrap: "azure_role", Related: owners},
+	{Path: "cft", Wrap: "cft", Related: owners, Lifted: []string{"tags"}},
+	{Path: "cloud-rule", Wrap: "cloud_rule", Related: map[string]string{
+		"aws_cloudformation_templates":            "cft_ids",
+		"aws_iam_policies":                        "iam_policy_ids",
+		"azure_arm_template_definitions":          "azure_arm_template_definition_ids",
+		"azure_policy_definitions":                "azure_policy_definition_ids",
+		"azure_role_definitions":                  "azure_role_definition_ids",
+		"compliance_standards":                    "compliance_standard_ids",
+		"gcp_iam_roles":                           "gcp_iam_role_ids",
+		"internal_aws_amis":                       "internal_ami_ids",
+		"internal_aws_service_catalog_portfolios": "internal_portfolio_ids",
+		"ous":                      "ou_ids",
+		"owner_user_groups":        "owner_user_group_ids",
+		"owner_users":              "owner_user_ids",
+		"projects":                 "project_ids",
+		"service_control_policies": "service_control_policy_ids",
+	}},
+	{Path: "compliance/check", Wrap: "compliance_check", Related: owners},
+	{Path: "compliance/standard", Wrap: "compliance_standard", Related: map[string]string{
+		"compliance_checks": "compliance_check_ids",
+		"owner_user_groups": "owner_user_group_ids",
+		"owner_users":       "owner_user_ids",
+	}},
+	{Path: "ct-config/financials-config", Singleton: true},
+	{Path: "custom-variable", Paged: true},
+	{Path: "funding-source"},
+	{Path: "gcp-iam-role", Wrap: "gcp_role", Related: owners},
+	{Path: "iam-policy", Wrap: "iam_policy", Related: owners},
+	{Path: "idms/group-association", Renamed: map[string]string{"update_on_login": "should_update_on_login"}},
+	{Path: "label", Paged: true},
+	{Path: "me/cloud-access-role"},
+	{Path: "ou", Wrap: "ou", Related: owners},
+	{Path: "ou-cloud-access-role", Wrap: "ou_cloud_access_role", Related: map[string]string{
+		"aws_iam_policies":       "aws_iam_policies",
+		"azure_role_definitions": "azure_role_definitions",
+		"gcp_iam_roles":          "gcp_iam_roles",
+		"user_groups":            "user_group_ids",
+		"users":                  "user_ids",
+	}},
+	{Path: "permission-scheme"},
+	{Path: "project"},
+	{Path: "project-cloud-access-role", Wrap: "project_cloud_access_role", Related: map[string]string{
+		"accounts":               "account_ids",
+		"aws_iam_policies":       "aws_iam_policies",
+		"azure_role_definitions": "azure_role_definitions",
+		"gcp_iam_roles":          "gcp_iam_roles",
+		"user_groups":            "user_group_ids",
+		"users":                  "user_ids",
+	}},
+	{Path: "project-note"},
+	{Path: "service-control-policy", Wrap: "service_control_policy", Related: owners},
+	{Path: "user", Wrap: "user"},
+	{Path: "user-group", Wrap: "user_group", Related: map[string]string{
+		"owner_group": "owner_user_group_ids",
+		"owner_users": "owner_user_ids",
+		"users":       "user_ids",
+	}},
+	{Path: "webhook", Wrap: "webhook", Related: owners},
+}
+
+// versionSegment matches the API version in a request path, e.g. "v3".
+var versionSegment = regexp.MustCompile(`^v\d+$`)
+
+// Server is a fake Kion API backed by in-memory collections.
+type Server struct {
+	*httptest.Server
+
+	mux *http.ServeMux
+
+	mu          sync.Mutex
+	collections []*collection
+	nextID      int
+	// global holds the state of endpoints that are not below an object,
+	// e.g. the global permission mappings.
+	global Object
+}
+
+type collection struct {
+	Collection
+	objects map[int]Object
+}
+
+// NewServer starts a fake Kion API serving DefaultCollections. Close it when
+// done, e.g. with t.Cleanup(server.Close).
+func NewServer() *Server {
+	s := &Server{mux: http.NewServeMux(), nextID: 1, global: make(Object)}
+	for _, c := range DefaultCollections {
+		s.AddCollection(c)
+	}
+	s.mux.HandleFunc("/", s.serveCollection)
+	s.Server = httptest.NewServer(s.mux)
+	return s
+}
+
+// AddCollection adds or replaces a collection. Existing objects of a replaced
+// collection are dropped.
+func (s *Server) AddCollection(c Collection) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.collections = slices.DeleteFunc(s.collections, func(existing *collection) bool {
+		return existing.Path == c.Path
+	})
+	s.collections = append(s.collections, &collection{Collection: c, objects: make(map[int]Object)})
+	// Match "compliance/check" before a collection named "compliance".
+	sort.SliceStable(s.collections, func(i, j int) bool {
+		return len(s.collections[i].Path) > len(s.collections[j].Path)
+	})
+}
+
+// Handle registers a handler for an endpoint the collections do not cover or
+// that a test wants to replace, using http.ServeMux patterns such as
+// "GET /api/v3/ou/{id}/permission-mapping". Handlers are matched against the
+// full request path.
+func (s *Server) Handle(pattern string, handler http.HandlerFunc) {
+	s.mux.HandleFunc(pattern, handler)
+}
+
+// Seed stores obj in the collection at path and returns its ID. Use it for
+// objects a test needs to exist before the provider runs, such as users.
+func (s *Server) Seed(path string, obj Object) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.collection(path)
+	if c == nil {
+		panic(fmt.Sprintf("kionfake: unknown collection %q", path))
+	}
+	return s.insert(c, obj)
+}
+
+// Object returns a copy of the object with the given ID, or nil if it does
+// not exist.
+func (s *Server) Object(path string, id int) Object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.collection(path)
+	if c == nil || c.objects[id] == nil {
+		return nil
+	}
+	return copyObject(c.objects[id])
+}
+
+// Objects returns copies of the objects in the collection at path, ordered by
+// ID.
+func (s *Server) Objects(path string) []Object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.collection(path)
+	if c == nil {
+		return nil
+	}
+	objects := make([]Object, 0, len(c.objects))
+	for _, id := range slices.Sorted(maps.Keys(c.objects)) {
+		objects = append(objects, copyObject(c.objects[id]))
+	}
+	return objects
+}
+
+// Len returns the number of objects in the collection at path.
+func (s *Server) Len(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if c := s.collection(path); c != nil {
+		return len(c.objects)
+	}
+	return 0
+}
+
+func (s *Server) collection(path string) *collection {
+	for _, c := range s.collections {
+		if c.Path == path {
+			return c
+		}
+	}
+	return nil
+}
+
+func (s *Server) insert(c *collection, obj Object) int {
+	id := s.nextID
+	s.nextID++
+
+	obj = copyObject(obj)
+	obj["id"] = id
+	c.objects[id] = obj
+	return id
+}
+
+// apiPath returns the request path after the API version, e.g. "ou/5/owner"
+// for "/api/v3/ou/5/owner".
+func apiPath(urlPath string) string {
+	segments := strings.Split(strings.Trim(urlPath, "/"), "/")
+	for i, segment := range segments {
+		if versionSegment.MatchString(segment) {
+			return strings.Join(segments[i+1:], "/")
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// route splits an API path into its collection, object ID and the remaining
+// sub-resource, e.g. "ou/5/owner" into ou, 5 and "owner".
+func (s *Server) route(rest string) (c *collection, id int, sub string, ok bool) {
+	for _, candidate := range s.collections {
+		after, found := strings.CutPrefix(rest, candidate.Path)
+		if !found || (after != "" && after[0] != '/') {
+			continue
+		}
+		after = strings.TrimPrefix(after, "/")
+		if after == "" {
+			return candidate, 0, "", true
+		}
+
+		idPart, sub, _ := strings.Cut(after, "/")
+		id, err := strconv.Atoi(idPart)
+		if err != nil {
+			return nil, 0, "", false
+		}
+		return candidate, id, sub, true
+	}
+	return nil, 0, "", false
+}
+
+func (s *Server) serveCollection(w http.ResponseWriter, r *http.Request) {
+	var body interface{}
+	if raw, _ := io.ReadAll(r.Body); len(raw) > 0 {
+		if err := json.Unmarshal(raw, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "request body is not valid JSON")
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rest := apiPath(r.URL.Path)
+	if global, found := strings.CutPrefix(rest, "global/"); found {
+		s.serveSubresource(w, r, s.global, global, body)
+		return
+	}
+
+	c, id, sub, ok := s.route(rest)
+	if !ok {
+		writeError(w, http.StatusNotFound, "no such endpoint")
+		return
+	}
+
+	if id == 0 && c.Singleton {
+		obj := c.objects[0]
+		if obj == nil {
+			obj = make(Object)
+			c.objects[0] = obj
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, map[string]interface{}{"data": copyObject(obj), "status": http.StatusOK})
+		case http.MethodPatch, http.MethodPut:
+			fields, isObject := body.(map[string]interface{})
+			if !isObject {
+				writeError(w, http.StatusBadRequest, "expected a JSON object")
+				return
+			}
+			for key, value := range fields {
+				obj[key] = value
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{"status": http.StatusOK})
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+		return
+	}
+
+	if id == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, map[string]interface{}{"data": s.list(c), "status": http.StatusOK})
+		case http.MethodPost:
+			obj, isObject := body.(map[string]interface{})
+			if !isObject {
+				writeError(w, http.StatusBadRequest, "expected a JSON object")
+				return
+			}
+			if c.WrappedCreate {
+				obj = c.unwrap(obj)
+			}
+			id := s.insert(c, c.rename(obj))
+			writeJSON(w, http.StatusCreated, map[string]interface{}{"record_id": id, "status": http.StatusCreated})
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+		return
+	}
+
+	obj := c.objects[id]
+	if obj == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %d not found", c.Path, id))
+		return
+	}
+
+	if sub != "" {
+		s.serveSubresource(w, r, obj, sub, body)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": c.render(obj), "status": http.StatusOK})
+	case http.MethodPatch, http.MethodPut:
+		fields, isObject := body.(map[string]interface{})
+		if !isObject {
+			writeError(w, http.StatusBadRequest, "expected a JSON object")
+			return
+		}
+		for key, value := range c.rename(fields) {
+			obj[key] = value
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"status": http.StatusOK})
+	case http.MethodDelete:
+		delete(c.objects, id)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"status": http.StatusOK})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// serveSubresource handles the association endpoints below an object:
+//
+//   - GET and PUT of "labels" read and replace the associated labels, which
+//     are stored in the "labels" field of the object.
+//   - GET of "permission-mapping" lists the mappings, PATCH and POST replace
+//     the mappings of the app roles they contain.
+//   - GET, PUT and DELETE of "custom-variable/{id}" read, set and clear the
+//     override of a custom variable.
+//   - POST and DELETE with an object body, e.g. "owner" or "association",
+//     add and remove the IDs of every list field.
+//   - POST and DELETE with an array body, e.g. "user" of a user group, add
+//     and remove IDs of the "<sub>_ids" field.
+func (s *Server) serveSubresource(w http.ResponseWriter, r *http.Request, obj Object, sub string, body interface{}) {
+	switch sub {
+	case "labels":
+		s.serveLabels(w, r, obj, body)
+		return
+	case "permission-mapping":
+		s.servePermissionMappings(w, r, obj, body)
+		return
+	}
+	if cvID, ok := strings.CutPrefix(sub, "custom-variable/"); ok {
+		s.serveOverride(w, r, obj, cvID, body)
+		return
+	}
+
+	changes := make(map[string][]int)
+	switch body := body.(type) {
+	case map[string]interface{}:
+		for key, value := range body {
+			if list, isList := value.([]interface{}); isList {
+				changes[key] = toIDs(list)
+			}
+		}
+	case []interface{}:
+		changes[sub+"_ids"] = toIDs(body)
+	default:
+		writeError(w, http.StatusBadRequest, "expected a JSON object or array")
+		return
+	}
+
+	for key, ids := range changes {
+		current := toIDs(obj[key])
+		switch r.Method {
+		case http.MethodPost:
+			for _, id := range ids {
+				if !slices.Contains(current, id) {
+					current = append(current, id)
+				}
+			}
+		case http.MethodDelete:
+			current = slices.DeleteFunc(current, func(id int) bool {
+				return slices.Contains(ids, id)
+			})
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		obj[key] = current
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"status": http.StatusOK})
+}
+
+func (s *Server) serveLabels(w http.ResponseWriter, r *http.Request, obj Object, body interface{}) {
+	switch r.Method {
+	case http.MethodGet:
+		labels, _ := obj["labels"].([]interface{})
+		if labels == nil {
+			labels = make([]interface{}, 0)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": labels, "status": http.StatusOK})
+	case http.MethodPut:
+		fields, _ := body.(map[string]interface{})
+		labels, _ := fields["labels"].([]interface{})
+		obj["labels"] = labels
+		writeJSON(w, http.StatusOK, map[string]interface{}{"status": http.StatusOK})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (s *Server) servePermissionMappings(w http.ResponseWriter, r *http.Request, obj Object, body interface{}) {
+	mappings, _ := obj["permission_mappings"].([]interface{})
+	switch r.Method {
+	case http.MethodGet:
+		if mappings == nil {
+			mappings = make([]interface{}, 0)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": mappings, "status": http.StatusOK})
+	case http.MethodPatch, http.MethodPost:
+		changes, isArray := body.([]interface{})
+		if !isArray {
+			writeError(w, http.StatusBadRequest, "expected a JSON array")
+			return
+		}
+		for _, change := range changes {
+			change, _ := change.(map[string]interface{})
+			role := change["app_role_id"]
+			mappings = slices.DeleteFunc(mappings, func(mapping interface{}) bool {
+				return mapping.(map[string]interface{})["app_role_id"] == role
+			})
+			// Kion drops an app role once it has no users or groups left.
+			if len(toIDs(change["user_ids"])) > 0 || len(toIDs(change["user_groups_ids"])) > 0 {
+				mappings = append(mappings, change)
+			}
+		}
+		obj["permission_mappings"] = mappings
+		writeJSON(w, http.StatusOK, map[string]interface{}{"status": http.StatusOK})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (s *Server) serveOverride(w http.ResponseWriter, r *http.Request, obj Object, cvID string, body interface{}) {
+	id, err := strconv.Atoi(cvID)
+	variables := s.collection("custom-variable")
+	if err != nil || variables == nil || variables.objects[id] == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("custom-variable %s not found", cvID))
+		return
+	}
+
+	overrides, _ := obj["custom_variable_overrides"].(map[int]interface{})
+	if overrides == nil {
+		overrides = make(map[int]interface{})
+		obj["custom_variable_overrides"] = overrides
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		variable := variables.objects[id]
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"data": map[string]interface{}{
+				"custom_variable_id":   id,
+				"custom_variable_type": variable["type"],
+				"inherited":            map[string]interface{}{"value": variable["default_value"]},
+				"override":             overrides[id],
+			},
+			"status": http.StatusOK,
+		})
+	case http.MethodPut:
+		overrides[id] = body
+		writeJSON(w, http.StatusOK, map[string]interface{}{"status": http.StatusOK})
+	case http.MethodDelete:
+		delete(overrides, id)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"status": http.StatusOK})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// list returns the objects of c. Kion lists objects flat, without the
+// wrapping of GET by ID responses.
+func (s *Server) list(c *collection) interface{} {
+	ids := make([]int, 0, len(c.objects))
+	for id := range c.objects {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	items := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, copyObject(c.objects[id]))
+	}
+	if c.Paged {
+		return map[string]interface{}{"items": items, "total": len(items)}
+	}
+	return items
+}
+
+// render shapes obj the way Kion returns it.
+func (c *collection) render(obj Object) interface{} {
+	if c.Wrap == "" {
+		return obj
+	}
+
+	inner := copyObject(obj)
+	out := map[string]interface{}{c.Wrap: inner}
+	for key, field := range c.Related {
+		related := make([]interface{}, 0)
+		for _, id := range toIDs(obj[field]) {
+			related = append(related, map[string]interface{}{"id": id})
+		}
+		out[key] = related
+		delete(inner, field)
+	}
+	for _, field := range c.Lifted {
+		out[field] = obj[field]
+		delete(inner, field)
+	}
+	return out
+}
+
+// rename applies Renamed to the fields of a request.
+func (c *collection) rename(fields Object) Object {
+	out := make(Object, len(fields))
+	for key, value := range fields {
+		if renamed, ok := c.Renamed[key]; ok {
+			key = renamed
+		}
+		out[key] = value
+	}
+	return out
+}
+
+// unwrap turns a create request shaped like a GET by ID response into the
+// stored object.
+func (c *collection) unwrap(body Object) Object {
+	obj, _ := body[c.Wrap].(map[string]interface{})
+	obj = copyObject(obj)
+	for key, field := range c.Related {
+		if ids, ok := body[key]; ok {
+			obj[field] = ids
+		}
+	}
+	return obj
+}
+
+func copyObject(obj Object) Object {
+	out := make(Object, len(obj))
+	for key, value := range obj {
+		out[key] = value
+	}
+	return out
+}
+
+// toIDs converts a decoded JSON array of numbers, or an []int, to IDs.
+func toIDs(v interface{}) []int {
+	switch v := v.(type) {
+	case []int:
+		return slices.Clone(v)
+	case []interface{}:
+		ids := make([]int, 0, len(v))
+		for _, item := range v {
+			switch item := item.(type) {
+			case float64:
+				ids = append(ids, int(item))
+			case int:
+				ids = append(ids, item)
+			}
+		}
+		return ids
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{"message": message, "status": status})
+}
@@ -3,11 +3,22 @@ package kion
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"regexp"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
 )
 
 var awsAccountCreationMux sync.Mutex
@@ -17,24 +28,155 @@ func Provider() *schema.Provider {
 	return &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"apikey": {
-				Description: "The API key generated from Kion. Example: app_1_XXXXXXXXXXXX.",
+				Description: "The API key generated from Kion. Example: app_1_XXXXXXXXXXXX. Exactly one of apikey, apikey_file, apikey_command or username must be set.",
 				Type:        schema.TypeString,
 				Sensitive:   true,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("KION_APIKEY", nil),
 			},
+			"apikey_command": {
+				Description: "A command run through the system shell whose standard output is the API key. It is run again whenever Kion rejects the key, so it can hand out short-lived credentials.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KION_APIKEY_COMMAND", nil),
+			},
+			"apikey_file": {
+				Description: "Path to a file containing the API key. The file is read again whenever Kion rejects the key, so the key can be rotated during a long apply.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KION_APIKEY_FILE", nil),
+			},
 			"apipath": {
 				Description: "The base path of the API. Defaults to /api",
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "/api",
 			},
+			"ca_cert_file": {
+				Description:   "Path to a PEM encoded CA bundle used, in addition to the system roots, to verify the Kion certificate. Use this instead of skipsslvalidation when Kion uses a certificate from an internal CA.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("KION_CA_CERT_FILE", nil),
+				ConflictsWith: []string{"ca_cert_pem"},
+			},
+			"ca_cert_pem": {
+				Description:   "PEM encoded CA bundle used, in addition to the system roots, to verify the Kion certificate. Conflicts with ca_cert_file.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("KION_CA_CERT_PEM", nil),
+				ConflictsWith: []string{"ca_cert_file"},
+			},
+			"client_cert": {
+				Description:  "PEM encoded client certificate, or the path to a file containing it, presented to Kion or the proxy when mutual TLS is required. Requires client_key.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("KION_CLIENT_CERT", nil),
+				RequiredWith: []string{"client_key"},
+			},
+			"client_key": {
+				Description:  "PEM encoded private key of client_cert, or the path to a file containing it. Requires client_cert.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				DefaultFunc:  schema.EnvDefaultFunc("KION_CLIENT_KEY", nil),
+				RequiredWith: []string{"client_cert"},
+			},
+			"create_missing_labels": {
+				Description: "If true, labels assigned to a resource that do not exist in Kion yet are created with missing_label_color instead of failing the apply. Defaults to false.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KION_CREATE_MISSING_LABELS", false),
+			},
+			"default_labels": {
+				Description: "A map of labels merged into the labels of every resource that supports them: accounts in a project, cloud rules, funding sources, OUs and projects. Labels set on a resource win over a default with the same key, and the plan shows the merged labels. The labels must already exist in Kion unless create_missing_labels is set.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"delete_unused_labels": {
+				Description: "If true, labels with missing_label_color that are removed from a resource, or belonged to a destroyed resource, are deleted once no account, cloud rule, funding source, OU or project uses them. Kion cannot report where a label is used, so this reads the labels of every object of these types. Defaults to false.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KION_DELETE_UNUSED_LABELS", false),
+			},
+			"enable_response_cache": {
+				Description: "If true, successful GET responses are cached in memory for the duration of a Terraform command and concurrent identical GETs are sent only once. Any create, update or delete invalidates the cached responses of the collection it targets. Reduces API calls when refreshing large configurations. Defaults to false.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KION_ENABLE_RESPONSE_CACHE", false),
+			},
+			"idms_id": {
+				Description:  "The ID of the identity management system username belongs to. Defaults to 1, the local Kion IDMS.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("KION_IDMS_ID", 1),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"max_concurrent_requests": {
+				Description:  "The maximum number of requests sent to Kion at the same time. Use this to protect small Kion installations when running Terraform with a high -parallelism. Defaults to 0, which means no limit.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("KION_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_retries": {
+				Description:  "The maximum number of times a request to Kion is retried after a transient failure (HTTP 429, 502, 503, 504 or a dropped connection). Only idempotent requests are retried. Defaults to 3.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("KION_MAX_RETRIES", hc.DefaultMaxRetries),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"missing_label_color": {
+				Description: "The color, in hex format, of labels created by create_missing_labels. Only labels with this color are deleted by delete_unused_labels, so use a color not given to labels managed otherwise. Defaults to #9e9e9e.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KION_MISSING_LABEL_COLOR", "#9e9e9e"),
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^#[0-9a-fA-F]{6}$"),
+					"must be a valid hex color code with leading #",
+				),
+			},
+			"password": {
+				Description:  "The password of username.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				DefaultFunc:  schema.EnvDefaultFunc("KION_PASSWORD", nil),
+				RequiredWith: []string{"username"},
+			},
+			"proxy_url": {
+				Description: "The URL of an HTTP or HTTPS proxy used for every request to Kion, for example http://proxy.example.com:3128. Defaults to the proxy configured through the HTTPS_PROXY and NO_PROXY environment variables.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KION_PROXY_URL", nil),
+			},
+			"requests_per_second": {
+				Description:  "The maximum average number of requests per second sent to Kion, including retries. Short bursts of up to one second's worth of requests are allowed. Defaults to 0, which means no limit.",
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("KION_REQUESTS_PER_SECOND", 0),
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"retry_max_wait": {
+				Description:  "The maximum number of seconds to wait between two attempts of a request, including waits requested by Kion through the Retry-After header. Defaults to 30.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("KION_RETRY_MAX_WAIT", int(hc.DefaultRetryMaxWait/time.Second)),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"skipsslvalidation": {
 				Description: "If true, will skip SSL validation.",
 				Type:        schema.TypeBool,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("KION_SKIPSSLVALIDATION", nil),
 			},
+			"username": {
+				Description:  "The username to log in to Kion with instead of using an API key. The session is renewed automatically when it expires during a long apply. Requires password.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("KION_USERNAME", nil),
+				RequiredWith: []string{"password"},
+			},
 			"url": {
 				Description: "The URL of a Kion installation. Example: https://kion.example.com.",
 				Type:        schema.TypeString,
@@ -43,6 +185,7 @@ func Provider() *schema.Provider {
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
+			"kion_app_api_key":                       resourceAppAPIKey(),
 			"kion_app_config":                        resourceAppConfig(),
 			"kion_aws_account":                       resourceAwsAccount(),
 			"kion_aws_cloudformation_template":       resourceAwsCloudformationTemplate(),
@@ -61,9 +204,11 @@ func Provider() *schema.Provider {
 			"kion_gcp_iam_role":                      resourceGcpIamRole(),
 			"kion_global_permission_mapping":         resourceGlobalPermissionsMapping(),
 			"kion_label":                             resourceLabel(),
+			"kion_label_association":                 resourceLabelAssociation(),
 			"kion_ou":                                resourceOU(),
 			"kion_ou_cloud_access_role":              resourceOUCloudAccessRole(),
 			"kion_ou_permission_mapping":             resourceOUPermissionsMapping(),
+			"kion_permission_scheme":                 resourcePermissionScheme(),
 			"kion_project":                           resourceProject(),
 			"kion_project_cloud_access_role":         resourceProjectCloudAccessRole(),
 			"kion_project_enforcement":               resourceProjectEnforcement(),
@@ -79,6 +224,7 @@ func Provider() *schema.Provider {
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"kion_account":                           dataSourceAccount(),
+			"kion_app_api_key":                       dataSourceAppAPIKey(),
 			"kion_app_config":                        dataSourceAppConfig(),
 			"kion_aws_cloudformation_template":       dataSourceAwsCloudformationTemplate(),
 			"kion_aws_iam_policy":                    dataSourceAwsIamPolicy(),
@@ -89,6 +235,7 @@ func Provider() *schema.Provider {
 			"kion_cloud_rule":                        dataSourceCloudRule(),
 			"kion_compliance_check":                  dataSourceComplianceCheck(),
 			"kion_compliance_standard":               dataSourceComplianceStandard(),
+			"kion_console_url":                       dataSourceConsoleURL(),
 			"kion_funding_source":                    dataSourceFundingSource(),
 			"kion_funding_source_permission_mapping": dataSourceFundingSourcePermissionsMapping(),
 			"kion_gcp_iam_role":                      dataSourceGcpIamRole(),
@@ -97,6 +244,7 @@ func Provider() *schema.Provider {
 			"kion_ou":                                dataSourceOU(),
 			"kion_ou_cloud_access_role":              dataSourceOUCloudAccessRole(),
 			"kion_ou_permission_mapping":             dataSourceOUPermissionsMapping(),
+			"kion_permission_scheme":                 dataSourcePermissionScheme(),
 			"kion_project":                           dataSourceProject(),
 			"kion_project_cloud_access_role":         dataSourceProjectCloudAccessRole(),
 			"kion_project_enforcement":               dataSourceProjectEnforcement(),
@@ -104,6 +252,7 @@ func Provider() *schema.Provider {
 			"kion_project_permission_mapping":        dataSourceProjectPermissionsMapping(),
 			"kion_saml_group_association":            dataSourceSamlGroupAssociation(),
 			"kion_service_control_policy":            dataServiceControlPolicy(),
+			"kion_temporary_credentials":             dataSourceTemporaryCredentials(),
 			"kion_user":                              dataSourceUser(),
 			"kion_user_group":                        dataSourceUserGroup(),
 			"kion_webhook":                           dataSourceWebhook(),
@@ -115,13 +264,17 @@ func Provider() *schema.Provider {
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	return configureClient(ctx, d, nil)
+}
+
+// configureClient creates the client of the provider. setup, if not nil, is
+// called before the client sends its first request, which lets acceptance
+// tests put the client in front of a cassette.
+func configureClient(ctx context.Context, d *schema.ResourceData, setup func(*hc.Client) error) (interface{}, diag.Diagnostics) {
 	kionURL := d.Get("url").(string)
 	kionAPIKey := d.Get("apikey").(string)
 	kionAPIPath := d.Get("apipath").(string)
 
-	// Warning or errors can be collected in a slice type
-	var diags diag.Diagnostics
-
 	var skipSSLValidation bool
 	v, ok := d.GetOk("skipsslvalidation")
 	if ok {
@@ -129,17 +282,248 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		skipSSLValidation = t
 	}
 
-	client := kionclient.NewClient(kionURL, kionAPIKey, kionAPIPath, skipSSLValidation)
-	err := client.GET("/v3/me/cloud-access-role", nil)
+	opts, diags := transportOptions(d)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	client, err := hc.NewClient(kionURL, kionAPIKey, kionAPIPath, skipSSLValidation, opts...)
 	if err != nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to create Kion client",
-			Detail:   "Unable to authenticate - " + err.Error(),
-		})
+		diags = append(diags, newClientErrorDiagnostic(d, err))
+		return nil, diags
+	}
+	if setup != nil {
+		if err := setup(client); err != nil {
+			diags = append(diags, newClientErrorDiagnostic(d, err))
+			return nil, diags
+		}
+	}
+	client.MaxRetries = d.Get("max_retries").(int)
+	client.RetryMaxWait = time.Duration(d.Get("retry_max_wait").(int)) * time.Second
+	client.SetRateLimit(d.Get("requests_per_second").(float64), 0)
+	client.SetMaxConcurrentRequests(d.Get("max_concurrent_requests").(int))
+	client.DefaultLabels = make(map[string]string)
+	for key, value := range d.Get("default_labels").(map[string]interface{}) {
+		client.DefaultLabels[key] = value.(string)
+	}
+	client.CreateMissingLabels = d.Get("create_missing_labels").(bool)
+	client.MissingLabelColor = d.Get("missing_label_color").(string)
+	client.DeleteUnusedLabels = d.Get("delete_unused_labels").(bool)
+	if d.Get("enable_response_cache").(bool) {
+		client.EnableCache()
+	}
 
+	authAttribute, authDiags := configureAuthentication(d, client)
+	diags = append(diags, authDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Every valid API key may read its own cloud access roles, which makes the
+	// endpoint a good configuration check. Decoding the response also catches
+	// an apipath that serves something other than the Kion API.
+	var probe struct {
+		Status int `json:"status"`
+	}
+	if err := client.GETContext(ctx, "/v3/me/cloud-access-role", &probe); err != nil {
+		diags = append(diags, configureErrorDiagnostic(client, authAttribute, err))
 		return nil, diags
 	}
 
 	return client, diags
 }
+
+// authAttributes are the provider attributes that select how the provider
+// authenticates. Exactly one of them must be set.
+var authAttributes = []string{"apikey", "apikey_file", "apikey_command", "username"}
+
+// configureAuthentication sets up how client authenticates and returns the
+// attribute that selected the method.
+func configureAuthentication(d *schema.ResourceData, client *hc.Client) (string, diag.Diagnostics) {
+	var set []string
+	for _, attribute := range authAttributes {
+		if _, ok := d.GetOk(attribute); ok {
+			set = append(set, attribute)
+		}
+	}
+	if len(set) != 1 {
+		return "", diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Invalid Kion authentication settings",
+			Detail:   fmt.Sprintf("Exactly one of apikey, apikey_file, apikey_command or username must be set (or KION_APIKEY, KION_APIKEY_FILE, KION_APIKEY_COMMAND or KION_USERNAME), got %d: %v.", len(set), set),
+		}}
+	}
+
+	switch set[0] {
+	case "apikey_file":
+		client.TokenSource = hc.FileTokenSource(d.Get("apikey_file").(string))
+	case "apikey_command":
+		client.TokenSource = hc.CommandTokenSource(d.Get("apikey_command").(string))
+	case "username":
+		client.TokenSource = client.SessionTokenSource(d.Get("idms_id").(int), d.Get("username").(string), d.Get("password").(string))
+	}
+	return set[0], nil
+}
+
+// transportOptions reads the TLS and proxy attributes of the provider into
+// client options. PEM values may be given inline or as a path to a file.
+func transportOptions(d *schema.ResourceData) ([]hc.ClientOption, diag.Diagnostics) {
+	var opts []hc.ClientOption
+	var diags diag.Diagnostics
+
+	if v, ok := d.GetOk("ca_cert_file"); ok {
+		pem, err := os.ReadFile(v.(string))
+		if err != nil {
+			return nil, append(diags, attributeErrorDiagnostic("ca_cert_file", "Unable to read CA certificate file", err))
+		}
+		opts = append(opts, hc.WithCACertPEM(pem))
+	} else if v, ok := d.GetOk("ca_cert_pem"); ok {
+		opts = append(opts, hc.WithCACertPEM([]byte(v.(string))))
+	}
+
+	if v, ok := d.GetOk("client_cert"); ok {
+		certPEM, err := readPEMOrFile(v.(string))
+		if err != nil {
+			return nil, append(diags, attributeErrorDiagnostic("client_cert", "Unable to read client certificate", err))
+		}
+		keyPEM, err := readPEMOrFile(d.Get("client_key").(string))
+		if err != nil {
+			return nil, append(diags, attributeErrorDiagnostic("client_key", "Unable to read client key", err))
+		}
+		opts = append(opts, hc.WithClientCertificate(certPEM, keyPEM))
+	}
+
+	if v, ok := d.GetOk("proxy_url"); ok {
+		opts = append(opts, hc.WithProxyURL(v.(string)))
+	}
+
+	return opts, diags
+}
+
+// readPEMOrFile returns value itself when it holds PEM data, and otherwise
+// treats it as a path and returns the content of that file.
+func readPEMOrFile(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(value)
+}
+
+// newClientErrorDiagnostic reports an error returned by hc.NewClient against
+// the provider attribute that caused it.
+func newClientErrorDiagnostic(d *schema.ResourceData, err error) diag.Diagnostic {
+	switch {
+	case errors.Is(err, hc.ErrInvalidURL):
+		return attributeErrorDiagnostic("url", "Invalid Kion URL",
+			fmt.Errorf("%w\nSet url (or KION_URL) to the address of your Kion installation, for example https://kion.example.com", err))
+	case errors.Is(err, hc.ErrInvalidCACert):
+		attribute := "ca_cert_pem"
+		if _, ok := d.GetOk("ca_cert_file"); ok {
+			attribute = "ca_cert_file"
+		}
+		return attributeErrorDiagnostic(attribute, "Invalid CA certificate", err)
+	case errors.Is(err, hc.ErrInvalidClientCert):
+		return attributeErrorDiagnostic("client_cert", "Invalid client certificate", err)
+	case errors.Is(err, hc.ErrInvalidProxyURL):
+		return attributeErrorDiagnostic("proxy_url", "Invalid proxy URL", err)
+	}
+
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "Unable to create Kion client",
+		Detail:   fmt.Sprintf("Error: %v", err),
+	}
+}
+
+// attributeErrorDiagnostic returns an error diagnostic for a provider attribute.
+func attributeErrorDiagnostic(attribute, summary string, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity:      diag.Error,
+		Summary:       summary,
+		Detail:        fmt.Sprintf("Error: %v", err),
+		AttributePath: cty.GetAttrPath(attribute),
+	}
+}
+
+// configureErrorDiagnostic turns the error of the request made while
+// configuring the provider into a diagnostic that names the provider
+// attribute the user most likely has to fix. authAttribute is the attribute
+// that selected the authentication method.
+func configureErrorDiagnostic(client *hc.Client, authAttribute string, err error) diag.Diagnostic {
+	var (
+		dnsErr        *net.DNSError
+		certErr       *tls.CertificateVerificationError
+		unknownCA     x509.UnknownAuthorityError
+		hostnameErr   x509.HostnameError
+		invalidCert   x509.CertificateInvalidError
+		recordErr     tls.RecordHeaderError
+		reqErr        *hc.RequestError
+		invalidStatus = errors.As(err, &reqErr) && reqErr.StatusCode >= 200 && reqErr.StatusCode < 300
+	)
+
+	switch {
+	case errors.As(err, &dnsErr):
+		return diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Unable to resolve the Kion host",
+			Detail:        fmt.Sprintf("Error: %v\nCheck that url (or KION_URL) is spelled correctly and that this machine can resolve %q.", err, dnsErr.Name),
+			AttributePath: cty.GetAttrPath("url"),
+		}
+	case errors.As(err, &certErr), errors.As(err, &unknownCA), errors.As(err, &hostnameErr),
+		errors.As(err, &invalidCert), errors.As(err, &recordErr):
+		return diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Unable to establish a TLS connection to Kion",
+			Detail:        fmt.Sprintf("Error: %v\nCheck that url (or KION_URL) uses the right scheme and host name and that the Kion certificate is trusted by this machine, or set ca_cert_file or ca_cert_pem to the CA that issued it. Setting skipsslvalidation to true disables certificate checks and should only be used for testing.", err),
+			AttributePath: cty.GetAttrPath("url"),
+		}
+	case errors.Is(err, hc.ErrUnauthorized) && authAttribute == "username":
+		return diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Kion login failed",
+			Detail:        fmt.Sprintf("Error: %v\nKion rejected the credentials. Check username, password and idms_id (or KION_USERNAME, KION_PASSWORD and KION_IDMS_ID).", err),
+			AttributePath: cty.GetAttrPath("password"),
+		}
+	case errors.Is(err, hc.ErrUnauthorized):
+		return diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid Kion API key",
+			Detail:        fmt.Sprintf("Error: %v\nKion rejected the API key. Check that %s (or KION_%s) yields a valid app API key that has not expired or been deleted.", err, authAttribute, strings.ToUpper(authAttribute)),
+			AttributePath: cty.GetAttrPath(authAttribute),
+		}
+	case errors.Is(err, hc.ErrForbidden):
+		return diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Insufficient Kion permissions",
+			Detail:        fmt.Sprintf("Error: %v\nThe credentials are valid but their user is not allowed to call the Kion API. Grant the user API access in Kion or use the credentials of another user.", err),
+			AttributePath: cty.GetAttrPath(authAttribute),
+		}
+	case errors.Is(err, hc.ErrNotFound), invalidStatus:
+		return diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Kion API not found",
+			Detail:        fmt.Sprintf("Error: %v\nNo supported Kion API answered at %s. Check that apipath matches the path Kion serves its API under (the default is /api) and that the Kion version is supported by this provider.", err, client.HostURL),
+			AttributePath: cty.GetAttrPath("apipath"),
+		}
+	case errors.Is(err, hc.ErrTokenUnavailable):
+		return diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Unable to obtain Kion credentials",
+			Detail:        fmt.Sprintf("Error: %v\nCheck the value of %s (or KION_%s).", err, authAttribute, strings.ToUpper(authAttribute)),
+			AttributePath: cty.GetAttrPath(authAttribute),
+		}
+	case errors.As(err, &reqErr) && reqErr.StatusCode == 0:
+		return diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Unable to connect to Kion",
+			Detail:        fmt.Sprintf("Error: %v\nCheck that url (or KION_URL) points at a running Kion installation reachable from this machine.", err),
+			AttributePath: cty.GetAttrPath("url"),
+		}
+	}
+
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "Unable to create Kion client",
+		Detail:   "Unable to authenticate - " + err.Error(),
+	}
+}
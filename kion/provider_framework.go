@@ -0,0 +1,165 @@
+package kion
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
+)
+
+// frameworkResources, frameworkDataSources and frameworkEphemeralResources are
+// the resources, data sources and ephemeral resources written with the plugin
+// framework. Resources that need ephemeral values, write-only attributes or
+// proper null handling are added here instead of to the SDKv2 provider.
+var (
+	frameworkResources          []func() resource.Resource
+	frameworkDataSources        []func() datasource.DataSource
+	frameworkEphemeralResources = []func() ephemeral.EphemeralResource{
+		newTemporaryCredentialsEphemeralResource,
+	}
+)
+
+// ProviderServerFactory returns a factory for the provider server, which
+// muxes the SDKv2 provider with the plugin framework provider. Terraform sees
+// a single provider; each resource is served by the provider that defines it.
+func ProviderServerFactory(ctx context.Context, version string) (func() tfprotov5.ProviderServer, error) {
+	return providerServerFactory(ctx, Provider(), version)
+}
+
+// providerServerFactory muxes sdkProvider with the plugin framework provider.
+func providerServerFactory(ctx context.Context, sdkProvider *schema.Provider, version string) (func() tfprotov5.ProviderServer, error) {
+	// The SDKv2 provider must come first: ConfigureProvider is called on the
+	// servers in order, and the framework provider reuses its client.
+	providers := []func() tfprotov5.ProviderServer{
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(NewFrameworkProvider(sdkProvider, version)),
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
+
+// frameworkProvider is the plugin framework half of the provider. It has the
+// schema of the SDKv2 provider, as muxed providers must agree on it, and hands
+// the client configured by the SDKv2 provider to its resources.
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+	version     string
+}
+
+var _ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+
+// NewFrameworkProvider returns the plugin framework provider that is muxed
+// with sdkProvider.
+func NewFrameworkProvider(sdkProvider *schema.Provider, version string) provider.Provider {
+	return &frameworkProvider{sdkProvider: sdkProvider, version: version}
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "kion"
+	resp.Version = p.version
+}
+
+func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	attributes := make(map[string]fwschema.Attribute, len(p.sdkProvider.Schema))
+	for name, s := range p.sdkProvider.Schema {
+		attribute, err := frameworkAttribute(s)
+		if err != nil {
+			resp.Diagnostics.AddError("Unsupported provider attribute", fmt.Sprintf("Error: %v\nItem: %v", err, name))
+			continue
+		}
+		attributes[name] = attribute
+	}
+	resp.Schema = fwschema.Schema{Attributes: attributes}
+}
+
+// frameworkAttribute converts an attribute of the SDKv2 provider schema.
+func frameworkAttribute(s *schema.Schema) (fwschema.Attribute, error) {
+	switch s.Type {
+	case schema.TypeString:
+		return fwschema.StringAttribute{
+			Description:        s.Description,
+			DeprecationMessage: s.Deprecated,
+			Optional:           s.Optional,
+			Required:           s.Required,
+			Sensitive:          s.Sensitive,
+		}, nil
+	case schema.TypeBool:
+		return fwschema.BoolAttribute{
+			Description:        s.Description,
+			DeprecationMessage: s.Deprecated,
+			Optional:           s.Optional,
+			Required:           s.Required,
+			Sensitive:          s.Sensitive,
+		}, nil
+	case schema.TypeInt:
+		return fwschema.Int64Attribute{
+			Description:        s.Description,
+			DeprecationMessage: s.Deprecated,
+			Optional:           s.Optional,
+			Required:           s.Required,
+			Sensitive:          s.Sensitive,
+		}, nil
+	case schema.TypeFloat:
+		return fwschema.Float64Attribute{
+			Description:        s.Description,
+			DeprecationMessage: s.Deprecated,
+			Optional:           s.Optional,
+			Required:           s.Required,
+			Sensitive:          s.Sensitive,
+		}, nil
+	case schema.TypeMap:
+		if elem, ok := s.Elem.(*schema.Schema); !ok || elem.Type != schema.TypeString {
+			break
+		}
+		return fwschema.MapAttribute{
+			ElementType:        types.StringType,
+			Description:        s.Description,
+			DeprecationMessage: s.Deprecated,
+			Optional:           s.Optional,
+			Required:           s.Required,
+			Sensitive:          s.Sensitive,
+		}, nil
+	}
+	return nil, fmt.Errorf("type %v is not supported", s.Type)
+}
+
+// Configure runs after the SDKv2 provider was configured, which already
+// validated the configuration and checked the connection to Kion.
+func (p *frameworkProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	client, ok := p.sdkProvider.Meta().(*hc.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Kion client not configured",
+			"The SDKv2 provider must be configured before the plugin framework provider. This is a bug in the provider.")
+		return
+	}
+
+	resp.DataSourceData = client
+	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return frameworkResources
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return frameworkDataSources
+}
+
+func (p *frameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return frameworkEphemeralResources
+}
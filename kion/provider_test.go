@@ -1,22 +1,61 @@
 package kion
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var testAccProviders map[string]*schema.Provider
 var testAccProvider *schema.Provider
 
+// testAccProtoV5ProviderFactories serve the muxed provider. Acceptance tests of
+// plugin framework resources must use them instead of testAccProviders.
+var testAccProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+	"kion": func() (tfprotov5.ProviderServer, error) {
+		serverFactory, err := providerServerFactory(context.Background(), testAccNewProvider(), "test")
+		if err != nil {
+			return nil, err
+		}
+		return serverFactory(), nil
+	},
+}
+
 func init() {
-	testAccProvider = Provider()
+	testAccProvider = testAccNewProvider()
 	testAccProviders = map[string]*schema.Provider{
 		"kion": testAccProvider,
 	}
 }
 
+// testAccNewProvider returns the provider of the acceptance tests. Its client
+// records to, or replays from, the cassette selected by testAccCassette.
+func testAccNewProvider() *schema.Provider {
+	p := Provider()
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		return configureClient(ctx, d, (*hc.Client).RecordFromEnv)
+	}
+	return p
+}
+
 func TestProvider(t *testing.T) {
 	if err := Provider().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
@@ -27,7 +66,125 @@ func TestProvider_impl(t *testing.T) {
 	_ = Provider()
 }
 
+func TestProviderServerFactory(t *testing.T) {
+	server, err := testAccProtoV5ProviderFactories["kion"]()
+	require.NoError(t, err)
+
+	// The mux server reports an error when the provider schemas differ.
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Diagnostics)
+	assert.Len(t, resp.Provider.Block.Attributes, len(Provider().Schema))
+	assert.Contains(t, resp.ResourceSchemas, "kion_ou")
+	assert.Contains(t, resp.DataSourceSchemas, "kion_ou")
+	assert.Contains(t, resp.EphemeralResourceSchemas, "kion_temporary_credentials")
+}
+
+func TestFrameworkProviderConfigure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[],"status":200}`))
+	}))
+	defer server.Close()
+
+	sdkProvider := Provider()
+	p := NewFrameworkProvider(sdkProvider, "test")
+
+	var resp provider.ConfigureResponse
+	p.Configure(context.Background(), provider.ConfigureRequest{}, &resp)
+	assert.True(t, resp.Diagnostics.HasError())
+
+	diags := sdkProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"url":    server.URL,
+		"apikey": "app_1_test",
+	}))
+	require.False(t, diags.HasError())
+
+	resp = provider.ConfigureResponse{}
+	p.Configure(context.Background(), provider.ConfigureRequest{}, &resp)
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.Same(t, sdkProvider.Meta(), resp.ResourceData)
+	assert.Same(t, sdkProvider.Meta(), resp.DataSourceData)
+}
+
+func TestTemporaryCredentials(t *testing.T) {
+	ctx := context.Background()
+	server, client := newFakeClient(t)
+	var requests []hc.TemporaryCredentialsRequest
+	server.Handle("POST /api/v3/temporary-credentials", func(w http.ResponseWriter, r *http.Request) {
+		var req hc.TemporaryCredentialsRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		requests = append(requests, req)
+		_, _ = w.Write([]byte(`{"data":{"access_key":"AKIA","secret_access_key":"secret","session_token":"session","expiration":"2026-01-01T00:00:00Z"},"status":200}`))
+	})
+	want := hc.TemporaryCredentialsRequest{AccountNumber: "111111111111", IAMRoleName: "developer"}
+
+	// The ephemeral resource.
+	r := newTemporaryCredentialsEphemeralResource()
+	r.(ephemeral.EphemeralResourceWithConfigure).Configure(ctx, ephemeral.ConfigureRequest{ProviderData: client}, &ephemeral.ConfigureResponse{})
+	var schemaResp ephemeral.SchemaResponse
+	r.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+	config := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"account_number":    tftypes.NewValue(tftypes.String, want.AccountNumber),
+		"aws_iam_role_name": tftypes.NewValue(tftypes.String, want.IAMRoleName),
+		"access_key_id":     tftypes.NewValue(tftypes.String, nil),
+		"secret_access_key": tftypes.NewValue(tftypes.String, nil),
+		"session_token":     tftypes.NewValue(tftypes.String, nil),
+		"expiration":        tftypes.NewValue(tftypes.String, nil),
+	})
+	resp := ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.Open(ctx, ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config}}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "open: %v", resp.Diagnostics)
+	var result temporaryCredentialsModel
+	require.False(t, resp.Result.Get(ctx, &result).HasError())
+	assert.Equal(t, "AKIA", result.AccessKeyID.ValueString())
+	assert.Equal(t, "secret", result.SecretAccessKey.ValueString())
+	assert.Equal(t, "session", result.SessionToken.ValueString())
+
+	// Opening before the provider is configured is an error, not a panic.
+	unconfigured := ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	newTemporaryCredentialsEphemeralResource().Open(ctx, ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config}}, &unconfigured)
+	require.True(t, unconfigured.Diagnostics.HasError())
+	assert.Equal(t, "Unconfigured client", unconfigured.Diagnostics[0].Summary())
+
+	// The data source fallback.
+	ds := Provider().DataSourcesMap["kion_temporary_credentials"]
+	d := ds.Data(nil)
+	require.NoError(t, d.Set("account_number", want.AccountNumber))
+	require.NoError(t, d.Set("aws_iam_role_name", want.IAMRoleName))
+	diags := ds.ReadContext(ctx, d, client)
+	require.False(t, diags.HasError(), "read: %v", diags)
+	assert.Equal(t, "secret", d.Get("secret_access_key"))
+	assert.Equal(t, "2026-01-01T00:00:00Z", d.Get("expiration"))
+
+	assert.Equal(t, []hc.TemporaryCredentialsRequest{want, want}, requests)
+}
+
+func TestConsoleURL(t *testing.T) {
+	ctx := context.Background()
+	server, client := newFakeClient(t)
+	server.Handle("POST /api/v3/console-access", func(w http.ResponseWriter, r *http.Request) {
+		var req hc.ConsoleURLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		_, _ = fmt.Fprintf(w, `{"data":{"url":"https://signin.example.com/%d/%d","expiration":"2026-01-01T00:00:00Z"},"status":200}`,
+			req.AccountID, req.CloudAccessRoleID)
+	})
+
+	ds := Provider().DataSourcesMap["kion_console_url"]
+	d := ds.Data(nil)
+	require.NoError(t, d.Set("account_id", 3))
+	require.NoError(t, d.Set("cloud_access_role_id", 7))
+	diags := ds.ReadContext(ctx, d, client)
+	require.False(t, diags.HasError(), "read: %v", diags)
+	assert.Equal(t, "3/7", d.Id())
+	assert.Equal(t, "https://signin.example.com/3/7", d.Get("url"))
+	assert.Equal(t, "2026-01-01T00:00:00Z", d.Get("expiration"))
+}
+
 func testAccPreCheck(t *testing.T) {
+	if mode := os.Getenv(hc.RecorderModeEnv); mode != "" {
+		testAccCassette(t, hc.RecorderMode(mode))
+	}
 	if err := os.Getenv("KION_URL"); err == "" {
 		t.Fatal("KION_URL must be set for acceptance tests")
 	}
@@ -35,3 +192,149 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("KION_APIKEY must be set for acceptance tests")
 	}
 }
+
+// testAccCassette points the provider at the cassette of the test under
+// testdata/cassettes unless KION_CASSETTE is set. Replays need no Kion
+// installation, so the URL and API key default to placeholders.
+func testAccCassette(t *testing.T, mode hc.RecorderMode) {
+	if os.Getenv(hc.CassetteEnv) == "" {
+		t.Setenv(hc.CassetteEnv, filepath.Join("testdata", "cassettes", t.Name()+".json"))
+	}
+	if mode != hc.RecorderModeReplay {
+		return
+	}
+	if os.Getenv("KION_URL") == "" {
+		t.Setenv("KION_URL", "https://kion.invalid")
+	}
+	if os.Getenv("KION_APIKEY") == "" {
+		t.Setenv("KION_APIKEY", "replayed")
+	}
+}
+
+func TestProviderConfigureDiagnostics(t *testing.T) {
+	cases := map[string]struct {
+		status    int
+		body      string
+		attribute string
+		summary   string
+	}{
+		"bad key":        {http.StatusUnauthorized, `{"message":"invalid token"}`, "apikey", "Invalid Kion API key"},
+		"no permissions": {http.StatusForbidden, `{"message":"forbidden"}`, "apikey", "Insufficient Kion permissions"},
+		"wrong path":     {http.StatusNotFound, `<html></html>`, "apipath", "Kion API not found"},
+		"not the API":    {http.StatusOK, `<html></html>`, "apipath", "Kion API not found"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			diags := configureTestProvider(t, server.URL)
+			assert.Len(t, diags, 1)
+			assert.Equal(t, tc.summary, diags[0].Summary)
+			assert.Equal(t, cty.GetAttrPath(tc.attribute), diags[0].AttributePath)
+		})
+	}
+
+	t.Run("invalid url", func(t *testing.T) {
+		diags := configureTestProvider(t, "kion.example.com")
+		assert.Len(t, diags, 1)
+		assert.Equal(t, "Invalid Kion URL", diags[0].Summary)
+		assert.Equal(t, cty.GetAttrPath("url"), diags[0].AttributePath)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"data":[],"status":200}`))
+		}))
+		defer server.Close()
+
+		assert.False(t, configureTestProvider(t, server.URL).HasError())
+	})
+}
+
+func TestProviderConfigureAuthentication(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	cases := map[string]struct {
+		config    map[string]interface{}
+		attribute cty.Path
+		summary   string
+	}{
+		"no method": {
+			config:  map[string]interface{}{},
+			summary: "Invalid Kion authentication settings",
+		},
+		"two methods": {
+			config:  map[string]interface{}{"apikey": "app_1_test", "apikey_file": "/tmp/apikey"},
+			summary: "Invalid Kion authentication settings",
+		},
+		"missing file": {
+			config:    map[string]interface{}{"apikey_file": filepath.Join(t.TempDir(), "missing")},
+			attribute: cty.GetAttrPath("apikey_file"),
+			summary:   "Unable to obtain Kion credentials",
+		},
+		"bad password": {
+			config:    map[string]interface{}{"username": "ci", "password": "wrong"},
+			attribute: cty.GetAttrPath("password"),
+			summary:   "Kion login failed",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.config["url"] = server.URL
+			tc.config["max_retries"] = 0
+			d := schema.TestResourceDataRaw(t, Provider().Schema, tc.config)
+			_, diags := providerConfigure(context.Background(), d)
+			assert.Len(t, diags, 1)
+			assert.Equal(t, tc.summary, diags[0].Summary)
+			assert.Equal(t, tc.attribute, diags[0].AttributePath)
+		})
+	}
+}
+
+func TestProviderConfigureRecorder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[],"status":200}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "configure.json")
+	t.Setenv(hc.RecorderModeEnv, string(hc.RecorderModeRecord))
+	t.Setenv(hc.CassetteEnv, path)
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"url":    server.URL,
+		"apikey": "app_1_test",
+	})
+
+	// The provider ignores the recorder variables; only the provider of the
+	// acceptance tests records.
+	client, diags := providerConfigure(context.Background(), d)
+	require.False(t, diags.HasError(), "configure: %v", diags)
+	_, recording := client.(*hc.Client).HTTPClient.Transport.(*hc.Recorder)
+	assert.False(t, recording)
+	assert.NoFileExists(t, path)
+
+	client, diags = testAccNewProvider().ConfigureContextFunc(context.Background(), d)
+	require.False(t, diags.HasError(), "configure: %v", diags)
+	assert.IsType(t, &hc.Recorder{}, client.(*hc.Client).HTTPClient.Transport)
+	assert.FileExists(t, path)
+}
+
+// configureTestProvider runs providerConfigure against the given URL without retries.
+func configureTestProvider(t *testing.T, url string) diag.Diagnostics {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"url":         url,
+		"apikey":      "app_1_test",
+		"max_retries": 0,
+	})
+	_, diags := providerConfigure(context.Background(), d)
+	return diags
+}
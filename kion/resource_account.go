@@ -2,6 +2,7 @@ package kion
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -62,24 +63,36 @@ func resourceAccountRead(resource string, ctx context.Context, d *schema.Resourc
 	if locationChanged || d.Get("location") != "" {
 		if accountLocation == ProjectLocation {
 			resp = new(hc.AccountResponse)
-			err = client.GET(fmt.Sprintf("/v3/account/%s", ID), resp)
+			err = client.GETContext(ctx, fmt.Sprintf("/v3/account/%s", ID), resp)
 		} else {
 			resp = new(hc.AccountCacheResponse)
-			err = client.GET(fmt.Sprintf("/v3/account-cache/%s", ID), resp)
+			err = client.GETContext(ctx, fmt.Sprintf("/v3/account-cache/%s", ID), resp)
 		}
 		// Return error if we can't find it in the specified location
 		if err != nil {
-			return append(diags, hc.HandleError(fmt.Errorf("unable to read account from %s (ID: %s): %v", accountLocation, ID, err))...)
+			if hc.RemoveFromStateIfNotFound(ctx, d, resource, err) {
+				return diags
+			}
+			return append(diags, hc.HandleError(fmt.Errorf("unable to read account from %s (ID: %s): %w", accountLocation, ID, err))...)
 		}
 	} else {
 		// If no explicit location, try project first then fall back to cache
 		resp = new(hc.AccountResponse)
-		err = client.GET(fmt.Sprintf("/v3/account/%s", ID), resp)
+		err = client.GETContext(ctx, fmt.Sprintf("/v3/account/%s", ID), resp)
 		if err != nil {
+			// Only treat the account as gone if it is missing from both locations.
+			projectErr := err
 			resp = new(hc.AccountCacheResponse)
-			err = client.GET(fmt.Sprintf("/v3/account-cache/%s", ID), resp)
+			err = client.GETContext(ctx, fmt.Sprintf("/v3/account-cache/%s", ID), resp)
 			if err == nil {
 				accountLocation = CacheLocation
+			} else if errors.Is(projectErr, hc.ErrNotFound) && hc.RemoveFromStateIfNotFound(ctx, d, resource, err) {
+				return diags
+			} else {
+				if !errors.Is(projectErr, hc.ErrNotFound) {
+					err = projectErr
+				}
+				return append(diags, hc.HandleError(fmt.Errorf("unable to read account (ID: %s): %w", ID, err))...)
 			}
 		} else {
 			accountLocation = ProjectLocation
@@ -96,9 +109,9 @@ func resourceAccountRead(resource string, ctx context.Context, d *schema.Resourc
 
 	// Handle labels for project accounts
 	if accountLocation == ProjectLocation {
-		labelData, err := hc.ReadResourceLabels(client, "account", ID)
+		labelData, err := hc.ReadResourceLabels(ctx, client, "account", ID)
 		if err != nil {
-			return append(diags, hc.HandleError(fmt.Errorf("unable to read account labels (ID: %s): %v", ID, err))...)
+			return append(diags, hc.HandleError(fmt.Errorf("unable to read account labels (ID: %s): %w", ID, err))...)
 		}
 		diags = append(diags, hc.SafeSet(d, "labels", labelData, "Failed to set account labels")...)
 	}
@@ -145,8 +158,8 @@ func resourceAccountUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	// Handle labels for project accounts
 	if accountLocation == ProjectLocation && d.HasChange("labels") {
 		hasChanged = true
-		if err := hc.PutAppLabelIDs(client, hc.FlattenAssociateLabels(d, "labels"), "account", ID); err != nil {
-			return append(diags, hc.HandleError(fmt.Errorf("unable to update account labels (ID: %s): %v", ID, err))...)
+		if err := hc.PutAppLabelIDs(ctx, client, hc.FlattenAssociateLabels(d, "labels"), "account", ID); err != nil {
+			return append(diags, hc.HandleError(fmt.Errorf("unable to update account labels (ID: %s): %w", ID, err))...)
 		}
 	}
 
@@ -246,8 +259,8 @@ func handleAccountFieldUpdate(ctx context.Context, d *schema.ResourceData, clien
 		"url":        accountURL,
 	})
 
-	if err := client.PATCH(accountURL, req); err != nil {
-		return append(diags, hc.HandleError(fmt.Errorf("failed to update account: %v", err))...)
+	if err := client.PATCHContext(ctx, accountURL, req); err != nil {
+		return append(diags, hc.HandleError(fmt.Errorf("failed to update account: %w", err))...)
 	}
 
 	return diags
@@ -274,18 +287,19 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, m interf
 		accountURL = fmt.Sprintf("/v3/account/%s", ID)
 	}
 
-	if err := client.DELETE(accountURL, nil); err != nil {
-		return append(diags, hc.HandleError(fmt.Errorf("failed to delete account (ID: %s): %v", ID, err))...)
+	if err := client.DELETEContext(ctx, accountURL, nil); err != nil {
+		return append(diags, hc.HandleError(fmt.Errorf("failed to delete account (ID: %s): %w", ID, err))...)
 	}
+	diags = append(diags, hc.DeleteUnusedResourceLabels(ctx, client, d)...)
 
 	d.SetId("")
 	return diags
 }
 
-func convertCacheAccountToProjectAccount(client *hc.Client, accountCacheID, projectID int, startDatecode string) (int, error) {
+func convertCacheAccountToProjectAccount(ctx context.Context, client *hc.Client, accountCacheID, projectID int, startDatecode string) (int, error) {
 	startDatecode = strings.ReplaceAll(startDatecode, "-", "")
 
-	resp, err := client.POST(fmt.Sprintf("/v3/account-cache/%d/convert/%d?start_datecode=%s",
+	resp, err := client.POSTContext(ctx, fmt.Sprintf("/v3/account-cache/%d/convert/%d?start_datecode=%s",
 		accountCacheID, projectID, startDatecode), nil)
 
 	if err != nil {
@@ -294,9 +308,9 @@ func convertCacheAccountToProjectAccount(client *hc.Client, accountCacheID, proj
 	return resp.RecordID, nil
 }
 
-func convertProjectAccountToCacheAccount(client *hc.Client, accountID int) (int, error) {
+func convertProjectAccountToCacheAccount(ctx context.Context, client *hc.Client, accountID int) (int, error) {
 	respRevert := new(hc.AccountRevertResponse)
-	err := client.DeleteWithResponse(fmt.Sprintf("/v3/account/revert/%d", accountID), nil, respRevert)
+	err := client.DeleteWithResponseContext(ctx, fmt.Sprintf("/v3/account/revert/%d", accountID), nil, respRevert)
 
 	if err != nil {
 		return 0, fmt.Errorf("failed to convert project account to cache account: %v", err)
@@ -312,7 +326,7 @@ func handleAccountConversion(ctx context.Context, d *schema.ResourceData, client
 		// Converting from cache to project
 		accountCacheID, err := strconv.Atoi(ID)
 		if err != nil {
-			return append(diags, hc.HandleError(fmt.Errorf("invalid account cache id: %v", err))...)
+			return append(diags, hc.HandleError(fmt.Errorf("invalid account cache id: %w", err))...)
 		}
 
 		tflog.Debug(ctx, "Converting from cached account to project account", map[string]interface{}{
@@ -320,9 +334,9 @@ func handleAccountConversion(ctx context.Context, d *schema.ResourceData, client
 			"project_id":       newProjectID,
 		})
 
-		newID, err := convertCacheAccountToProjectAccount(client, accountCacheID, newProjectID, d.Get("start_datecode").(string))
+		newID, err := convertCacheAccountToProjectAccount(ctx, client, accountCacheID, newProjectID, d.Get("start_datecode").(string))
 		if err != nil {
-			return append(diags, hc.HandleError(fmt.Errorf("failed to convert cache account to project: %v", err))...)
+			return append(diags, hc.HandleError(fmt.Errorf("failed to convert cache account to project: %w", err))...)
 		}
 
 		d.SetId(fmt.Sprintf("%d", newID))
@@ -332,16 +346,16 @@ func handleAccountConversion(ctx context.Context, d *schema.ResourceData, client
 		// Converting from project to cache
 		accountID, err := strconv.Atoi(ID)
 		if err != nil {
-			return append(diags, hc.HandleError(fmt.Errorf("invalid account id: %v", err))...)
+			return append(diags, hc.HandleError(fmt.Errorf("invalid account id: %w", err))...)
 		}
 
 		tflog.Debug(ctx, "Converting from project account to cached account", map[string]interface{}{
 			"account_id": accountID,
 		})
 
-		newID, err := convertProjectAccountToCacheAccount(client, accountID)
+		newID, err := convertProjectAccountToCacheAccount(ctx, client, accountID)
 		if err != nil {
-			return append(diags, hc.HandleError(fmt.Errorf("failed to convert project account to cache: %v", err))...)
+			return append(diags, hc.HandleError(fmt.Errorf("failed to convert project account to cache: %w", err))...)
 		}
 
 		d.SetId(fmt.Sprintf("%d", newID))
@@ -0,0 +1,229 @@
+package kion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
+)
+
+func resourceAppAPIKey() *schema.Resource {
+	return &schema.Resource{
+		Description: "Creates an app API key. The secret is only returned by Kion when the key is created, so it is " +
+			"empty after an import. A rotation creates the replacement key before the old key is revoked.",
+		CreateContext: resourceAppAPIKeyCreate,
+		ReadContext:   resourceAppAPIKeyRead,
+		UpdateContext: resourceAppAPIKeyUpdate,
+		DeleteContext: resourceAppAPIKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiry": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the key expires, following app_api_key_lifespan of the app config.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret of the key.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rotate_when_changed": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that rotate the key when they change, e.g. a timestamp.",
+			},
+			"rotation_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Rotate the key on the first apply once it is this many days old. It should be shorter than app_api_key_lifespan.",
+			},
+			"user_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the user the key authenticates as. Defaults to the user the provider authenticates as.",
+			},
+		},
+		CustomizeDiff: appAPIKeyRotationDiff,
+	}
+}
+
+func resourceAppAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*hc.Client)
+
+	if err := createAppAPIKey(ctx, client, d); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create AppAPIKey",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), d.Get("name")),
+		})
+		return diags
+	}
+
+	return resourceAppAPIKeyRead(ctx, d, m)
+}
+
+func resourceAppAPIKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*hc.Client)
+	ID := d.Id()
+
+	resp := new(hc.AppAPIKeyResponse)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/app-api-key/%s", ID), resp)
+	if err != nil {
+		// Kion deletes keys once they expire.
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_app_api_key", err) {
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read AppAPIKey",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+	item := resp.Data
+
+	data := make(map[string]interface{})
+	data["created_at"] = item.CreatedAt
+	data["expiry"] = item.Expiry
+	data["name"] = item.Name
+	data["user_id"] = item.UserID
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read and set AppAPIKey",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// resourceAppAPIKeyUpdate rotates the key when appAPIKeyRotationDiff planned a
+// new one. Other changes only update the state.
+func resourceAppAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*hc.Client)
+	oldID := d.Id()
+
+	if !d.HasChanges("rotate_when_changed", "created_at") {
+		return resourceAppAPIKeyRead(ctx, d, m)
+	}
+
+	if err := createAppAPIKey(ctx, client, d); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to rotate AppAPIKey",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), oldID),
+		})
+		return diags
+	}
+
+	// The state holds the new key from here on, even if the old one cannot
+	// be revoked.
+	err := client.DELETEContext(ctx, fmt.Sprintf("/v3/app-api-key/%s", oldID), nil)
+	if err != nil && !errors.Is(err, hc.ErrNotFound) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to revoke rotated AppAPIKey",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), oldID),
+		})
+	}
+
+	return append(diags, resourceAppAPIKeyRead(ctx, d, m)...)
+}
+
+func resourceAppAPIKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*hc.Client)
+	ID := d.Id()
+
+	err := client.DELETEContext(ctx, fmt.Sprintf("/v3/app-api-key/%s", ID), nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete AppAPIKey",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+// createAppAPIKey creates a key for the configuration of d and stores its ID
+// and secret in d.
+func createAppAPIKey(ctx context.Context, client *hc.Client, d *schema.ResourceData) error {
+	post := hc.AppAPIKeyCreate{
+		Name:   d.Get("name").(string),
+		UserID: d.Get("user_id").(int),
+	}
+
+	resp := new(hc.AppAPIKeyCreateResponse)
+	if err := client.PostWithResponseContext(ctx, "/v3/app-api-key", post, resp); err != nil {
+		return err
+	} else if resp.Data.ID == 0 {
+		return errors.New("received item ID of 0")
+	}
+
+	d.SetId(strconv.Itoa(resp.Data.ID))
+	return d.Set("key", resp.Data.Key)
+}
+
+// appAPIKeyRotationDiff plans a new key when rotate_when_changed changed or
+// the key is older than rotation_days.
+func appAPIKeyRotationDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	rotate := d.HasChange("rotate_when_changed")
+	if days := d.Get("rotation_days").(int); days > 0 {
+		// A key without a parsable creation time is never rotated by age.
+		createdAt, err := time.Parse(time.RFC3339, d.Get("created_at").(string))
+		if err == nil && !time.Now().Before(createdAt.AddDate(0, 0, days)) {
+			rotate = true
+		}
+	}
+	if !rotate {
+		return nil
+	}
+
+	for _, key := range []string{"created_at", "expiry", "key"} {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
 )
 
@@ -146,10 +148,26 @@ func resourceAppConfig() *schema.Resource {
 				Description: "The SMTP host.",
 			},
 			"smtp_password": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Sensitive:   true,
-				Description: "The SMTP password.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"smtp_password_wo"},
+				Description:   "The SMTP password. Use smtp_password_wo to keep the password out of the state.",
+			},
+			"smtp_password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"smtp_password"},
+				RequiredWith:  []string{"smtp_password_wo_version"},
+				Description:   "The SMTP password. The password is never stored in the plan or state and requires Terraform 1.11 or later.",
+			},
+			"smtp_password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"smtp_password_wo"},
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The version of smtp_password_wo. The password is only sent to Kion on the first apply and when the version changes.",
 			},
 			"smtp_port": {
 				Type:        schema.TypeInt,
@@ -173,6 +191,9 @@ func resourceAppConfig() *schema.Resource {
 				Description: "The list of supported AWS regions.",
 			},
 		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("smtp_password"), cty.GetAttrPath("smtp_password_wo")),
+		},
 	}
 }
 
@@ -196,7 +217,7 @@ func resourceAppConfigRead(ctx context.Context, d *schema.ResourceData, m interf
 	var diags diag.Diagnostics
 
 	var reqPayload hc.AppConfigResponse
-	err := client.GET("/v3/app-config", &reqPayload)
+	err := client.GETContext(ctx, "/v3/app-config", &reqPayload)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -232,7 +253,12 @@ func resourceAppConfigRead(ctx context.Context, d *schema.ResourceData, m interf
 	diags = append(diags, hc.SafeSet(d, "smtp_enabled", appConfig.SMTPEnabled, "Error setting smtp_enabled")...)
 	diags = append(diags, hc.SafeSet(d, "smtp_from", appConfig.SMTPFrom, "Error setting smtp_from")...)
 	diags = append(diags, hc.SafeSet(d, "smtp_host", appConfig.SMTPHost, "Error setting smtp_host")...)
-	diags = append(diags, hc.SafeSet(d, "smtp_password", appConfig.SMTPPassword, "Error setting smtp_password")...)
+	// A password set through smtp_password_wo is kept out of the state.
+	if _, ok := d.GetOk("smtp_password_wo_version"); ok {
+		diags = append(diags, hc.SafeSet(d, "smtp_password", "", "Error setting smtp_password")...)
+	} else {
+		diags = append(diags, hc.SafeSet(d, "smtp_password", appConfig.SMTPPassword, "Error setting smtp_password")...)
+	}
 	diags = append(diags, hc.SafeSet(d, "smtp_port", appConfig.SMTPPort, "Error setting smtp_port")...)
 	diags = append(diags, hc.SafeSet(d, "smtp_skip_verify", appConfig.SMTPSkipVerify, "Error setting smtp_skip_verify")...)
 	diags = append(diags, hc.SafeSet(d, "smtp_username", appConfig.SMTPUsername, "Error setting smtp_username")...)
@@ -325,6 +351,17 @@ func resourceAppConfigUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	if strPtr := hc.OptionalValue[string](d, "smtp_password"); strPtr != nil {
 		appConfig.SMTPPassword = *strPtr
 	}
+	// smtp_password_wo is only sent on the first apply and when its version
+	// changes, since the state cannot tell whether the password itself changed.
+	if d.IsNewResource() || d.HasChange("smtp_password_wo_version") {
+		password, err := hc.WriteOnlyString(d, "smtp_password_wo")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if password != "" {
+			appConfig.SMTPPassword = password
+		}
+	}
 	if intPtr := hc.OptionalValue[int](d, "smtp_port"); intPtr != nil {
 		appConfig.SMTPPort = int64(*intPtr)
 	}
@@ -338,7 +375,7 @@ func resourceAppConfigUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		appConfig.SupportedAWSRegions = hc.FlattenStringArray(v.([]interface{}))
 	}
 
-	err := client.PATCH("/v3/app-config", appConfig)
+	err := client.PATCHContext(ctx, "/v3/app-config", appConfig)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -3,6 +3,7 @@ package kion
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -132,9 +133,10 @@ func resourceAwsAccount() *schema.Resource {
 			"labels": {
 				Type:         schema.TypeMap,
 				Optional:     true,
+				Computed:     true,
 				RequiredWith: []string{"project_id"},
 				Elem:         &schema.Schema{Type: schema.TypeString},
-				Description:  "A map of labels to assign to the account. The labels must already exist in Kion.",
+				Description:  "A map of labels to assign to the account. The labels must already exist in Kion unless create_missing_labels is set on the provider.",
 			},
 			"last_updated": {
 				Type:     schema.TypeString,
@@ -226,6 +228,7 @@ func resourceAwsAccount() *schema.Resource {
 			// schema validators don't support multi-attribute validations, so we use CustomizeDiff instead
 			validateAwsAccountStartDatecode,
 			customDiffComputedAccountLocation,
+			hc.AccountDefaultLabelsDiff,
 		),
 	}
 }
@@ -302,9 +305,9 @@ func resourceAwsAccountCreate(ctx context.Context, d *schema.ResourceData, m int
 			})
 		}
 
-		resp, err := client.POST(accountURL, postAccountData)
+		resp, err := client.POSTContext(ctx, accountURL, postAccountData)
 		if err != nil {
-			diags = append(diags, hc.HandleError(fmt.Errorf("unable to import AWS Account: %v", err))...)
+			diags = append(diags, hc.HandleError(fmt.Errorf("unable to import AWS Account: %w", err))...)
 			return diags
 		}
 
@@ -325,7 +328,7 @@ func resourceAwsAccountCreate(ctx context.Context, d *schema.ResourceData, m int
 
 			newID, err := retryConvertCacheAccountToProjectAccountForAWS(ctx, client, accountCacheID, projectID, startDatecode, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
-				diags = append(diags, hc.HandleError(fmt.Errorf("unable to convert AWS cached account to project account: %v", err))...)
+				diags = append(diags, hc.HandleError(fmt.Errorf("unable to convert AWS cached account to project account: %w", err))...)
 				return diags
 			}
 
@@ -341,8 +344,8 @@ func resourceAwsAccountCreate(ctx context.Context, d *schema.ResourceData, m int
 	if accountLocation == ProjectLocation {
 		if _, ok := d.GetOk("labels"); ok {
 			ID := d.Id()
-			if err := hc.PutAppLabelIDs(client, hc.FlattenAssociateLabels(d, "labels"), "account", ID); err != nil {
-				return append(diags, hc.HandleError(fmt.Errorf("unable to update AWS account labels (ID: %s): %v", ID, err))...)
+			if err := hc.PutAppLabelIDs(ctx, client, hc.FlattenAssociateLabels(d, "labels"), "account", ID); err != nil {
+				return append(diags, hc.HandleError(fmt.Errorf("unable to update AWS account labels (ID: %s): %w", ID, err))...)
 			}
 		}
 	}
@@ -371,7 +374,7 @@ func createAwsAccount(ctx context.Context, client *hc.Client, d *schema.Resource
 
 	// Populate organizational unit details from Terraform resource data
 	if err := populateOrgUnitFromResourceData(d, &postCacheData); err != nil {
-		return append(diags, hc.HandleError(fmt.Errorf("failed to populate organizational unit data: %v", err))...), 0
+		return append(diags, hc.HandleError(fmt.Errorf("failed to populate organizational unit data: %w", err))...), 0
 	}
 
 	// Log the request data
@@ -382,17 +385,17 @@ func createAwsAccount(ctx context.Context, client *hc.Client, d *schema.Resource
 	}
 
 	// Send the POST request to create the AWS account
-	respCache, err := client.POST("/v3/account-cache/create?account-type=aws", postCacheData)
+	respCache, err := client.POSTContext(ctx, "/v3/account-cache/create?account-type=aws", postCacheData)
 	if err != nil || respCache.RecordID == 0 {
 		if err == nil {
 			err = fmt.Errorf("received item ID of 0")
 		}
-		return append(diags, hc.HandleError(fmt.Errorf("unable to create AWS Account: %v", err))...), 0
+		return append(diags, hc.HandleError(fmt.Errorf("unable to create AWS Account: %w", err))...), 0
 	}
 
 	// Wait for the account to be fully created
 	if err := waitForAccountCreation(client, ctx, respCache.RecordID, d); err != nil {
-		return append(diags, hc.HandleError(fmt.Errorf("failed waiting for account creation: %v", err))...), 0
+		return append(diags, hc.HandleError(fmt.Errorf("failed waiting for account creation: %w", err))...), 0
 	}
 
 	return diags, respCache.RecordID
@@ -427,7 +430,7 @@ func waitForAccountCreation(client *hc.Client, ctx context.Context, accountCache
 	createStateConf := &retry.StateChangeConf{
 		Refresh: func() (interface{}, string, error) {
 			resp := new(hc.AccountResponse)
-			err := client.GET(fmt.Sprintf("/v3/account-cache/%d", accountCacheID), resp)
+			err := client.GETContext(ctx, fmt.Sprintf("/v3/account-cache/%d", accountCacheID), resp, hc.WithoutCache())
 			if err != nil {
 				tflog.Trace(ctx, fmt.Sprintf("Checking new AWS account status: /v3/account-cache/%d error", accountCacheID), map[string]interface{}{"error": err, "accountCacheID": accountCacheID})
 				return nil, "", err
@@ -457,7 +460,7 @@ func waitForAccountCreation(client *hc.Client, ctx context.Context, accountCache
 	statusStateConf := &retry.StateChangeConf{
 		Refresh: func() (interface{}, string, error) {
 			resp := new(hc.AccountCacheStatusResponse)
-			err := client.GET(fmt.Sprintf("/v3/account-cache/%d/status", accountCacheID), resp)
+			err := client.GETContext(ctx, fmt.Sprintf("/v3/account-cache/%d/status", accountCacheID), resp, hc.WithoutCache())
 			if err != nil {
 				tflog.Trace(ctx, fmt.Sprintf("Checking new AWS account accessibility: /v3/account-cache/%d/status error", accountCacheID), map[string]interface{}{"error": err, "accountCacheID": accountCacheID})
 				return nil, "", err
@@ -487,7 +490,7 @@ func waitForAccountCreation(client *hc.Client, ctx context.Context, accountCache
 func retryConvertCacheAccountToProjectAccountForAWS(ctx context.Context, client *hc.Client, accountCacheID, projectID int, startDatecode string, timeout time.Duration) (int, error) {
 	var newID int
 	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
-		id, err := convertCacheAccountToProjectAccount(client, accountCacheID, projectID, startDatecode)
+		id, err := convertCacheAccountToProjectAccount(ctx, client, accountCacheID, projectID, startDatecode)
 		if err != nil {
 			tflog.Debug(ctx, "Error during cache-to-project conversion, will retry", map[string]interface{}{
 				"account_cache_id": accountCacheID,
@@ -540,24 +543,31 @@ func resourceAwsAccountRead(ctx context.Context, d *schema.ResourceData, m inter
 	if accountLocation == ProjectLocation {
 		// Try project account first
 		resp = new(hc.AccountResponse)
-		err = client.GET(fmt.Sprintf("/v3/account/%s", ID), resp)
+		err = client.GETContext(ctx, fmt.Sprintf("/v3/account/%s", ID), resp)
 		if err != nil && !locationChanged {
 			// If project account lookup fails and location wasn't explicitly set,
-			// try cache account
+			// try cache account. Only treat the account as gone if it is missing
+			// from both locations.
+			projectErr := err
 			resp = new(hc.AccountCacheResponse)
-			err = client.GET(fmt.Sprintf("/v3/account-cache/%s", ID), resp)
+			err = client.GETContext(ctx, fmt.Sprintf("/v3/account-cache/%s", ID), resp)
 			if err == nil {
 				accountLocation = CacheLocation
+			} else if !errors.Is(projectErr, hc.ErrNotFound) {
+				err = projectErr
 			}
 		}
 	} else {
 		// Try cache account directly if that's what was specified
 		resp = new(hc.AccountCacheResponse)
-		err = client.GET(fmt.Sprintf("/v3/account-cache/%s", ID), resp)
+		err = client.GETContext(ctx, fmt.Sprintf("/v3/account-cache/%s", ID), resp)
 	}
 
 	if err != nil {
-		return append(diags, hc.HandleError(fmt.Errorf("unable to read AWS account (ID: %s): %v", ID, err))...)
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_aws_account", err) {
+			return diags
+		}
+		return append(diags, hc.HandleError(fmt.Errorf("unable to read AWS account (ID: %s): %w", ID, err))...)
 	}
 
 	// Set location if it was determined during the read
@@ -573,9 +583,9 @@ func resourceAwsAccountRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	// Handle labels for project accounts
 	if accountLocation == ProjectLocation {
-		labelData, err := hc.ReadResourceLabels(client, "account", ID)
+		labelData, err := hc.ReadResourceLabels(ctx, client, "account", ID)
 		if err != nil {
-			return append(diags, hc.HandleError(fmt.Errorf("unable to read AWS account labels (ID: %s): %v", ID, err))...)
+			return append(diags, hc.HandleError(fmt.Errorf("unable to read AWS account labels (ID: %s): %w", ID, err))...)
 		}
 		diags = append(diags, hc.SafeSet(d, "labels", labelData, "Failed to set account labels")...)
 	}
@@ -633,14 +643,14 @@ func resourceAwsAccountUpdate(ctx context.Context, d *schema.ResourceData, m int
 	// Handle label changes for project accounts
 	if getKionAccountLocation(d) == ProjectLocation && d.HasChange("labels") {
 		hasChanged = true
-		if err := hc.PutAppLabelIDs(client, hc.FlattenAssociateLabels(d, "labels"), "account", ID); err != nil {
-			return append(diags, hc.HandleError(fmt.Errorf("unable to update AWS account labels (ID: %s): %v", ID, err))...)
+		if err := hc.PutAppLabelIDs(ctx, client, hc.FlattenAssociateLabels(d, "labels"), "account", ID); err != nil {
+			return append(diags, hc.HandleError(fmt.Errorf("unable to update AWS account labels (ID: %s): %w", ID, err))...)
 		}
 	}
 
 	if hasChanged {
 		if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
-			return append(diags, hc.HandleError(fmt.Errorf("unable to set last_updated: %v", err))...)
+			return append(diags, hc.HandleError(fmt.Errorf("unable to set last_updated: %w", err))...)
 		}
 	}
 
@@ -681,7 +691,7 @@ func handleCacheToProjectConversion(ctx context.Context, d *schema.ResourceData,
 
 	accountCacheID, err := strconv.Atoi(ID)
 	if err != nil {
-		return append(diags, hc.HandleError(fmt.Errorf("invalid account cache ID: %v", err))...)
+		return append(diags, hc.HandleError(fmt.Errorf("invalid account cache ID: %w", err))...)
 	}
 
 	projectID := d.Get("project_id").(int)
@@ -693,9 +703,9 @@ func handleCacheToProjectConversion(ctx context.Context, d *schema.ResourceData,
 		"start_datecode":   startDatecode,
 	})
 
-	newID, err := convertCacheAccountToProjectAccount(client, accountCacheID, projectID, startDatecode)
+	newID, err := convertCacheAccountToProjectAccount(ctx, client, accountCacheID, projectID, startDatecode)
 	if err != nil {
-		return append(diags, hc.HandleError(fmt.Errorf("failed to convert cache account to project: %v", err))...)
+		return append(diags, hc.HandleError(fmt.Errorf("failed to convert cache account to project: %w", err))...)
 	}
 
 	d.SetId(fmt.Sprintf("%d", newID))
@@ -706,16 +716,16 @@ func handleProjectToCacheConversion(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	accountID, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return append(diags, hc.HandleError(fmt.Errorf("invalid account ID: %v", err))...)
+		return append(diags, hc.HandleError(fmt.Errorf("invalid account ID: %w", err))...)
 	}
 
 	tflog.Debug(ctx, "Converting AWS account from project to cache", map[string]interface{}{
 		"account_id": accountID,
 	})
 
-	newID, err := convertProjectAccountToCacheAccount(client, accountID)
+	newID, err := convertProjectAccountToCacheAccount(ctx, client, accountID)
 	if err != nil {
-		return append(diags, hc.HandleError(fmt.Errorf("failed to convert project account to cache: %v", err))...)
+		return append(diags, hc.HandleError(fmt.Errorf("failed to convert project account to cache: %w", err))...)
 	}
 
 	d.SetId(fmt.Sprintf("%d", newID))
@@ -752,7 +762,7 @@ func handleProjectToProjectMove(ctx context.Context, d *schema.ResourceData, cli
 		"move_date":         req.MoveDate,
 	})
 
-	resp, err := client.POST(fmt.Sprintf("/v3/account/%s/move", ID), req)
+	resp, err := client.POSTContext(ctx, fmt.Sprintf("/v3/account/%s/move", ID), req)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to move account between projects: %v", err))
 	}
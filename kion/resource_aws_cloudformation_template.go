@@ -120,7 +120,7 @@ func resourceAwsCloudformationTemplateCreate(ctx context.Context, d *schema.Reso
 		TerminationProtection: d.Get("termination_protection").(bool),
 	}
 
-	resp, err := client.POST("/v3/cft", post)
+	resp, err := client.POSTContext(ctx, "/v3/cft", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -150,8 +150,11 @@ func resourceAwsCloudformationTemplateRead(ctx context.Context, d *schema.Resour
 	ID := d.Id()
 
 	resp := new(hc.CFTResponseWithOwnersAndTags)
-	err := client.GET(fmt.Sprintf("/v3/cft/%s", ID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/cft/%s", ID), resp)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_aws_cloudformation_template", err) {
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read AwsCloudformationTemplate",
@@ -225,7 +228,7 @@ func resourceAwsCloudformationTemplateUpdate(ctx context.Context, d *schema.Reso
 			TerminationProtection: d.Get("termination_protection").(bool),
 		}
 
-		err := client.PATCH(fmt.Sprintf("/v3/cft/%s", ID), req)
+		err := client.PATCHContext(ctx, fmt.Sprintf("/v3/cft/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -245,7 +248,7 @@ func resourceAwsCloudformationTemplateUpdate(ctx context.Context, d *schema.Reso
 
 		if len(arrAddOwnerUserGroupIds) > 0 ||
 			len(arrAddOwnerUserIds) > 0 {
-			_, err := client.POST(fmt.Sprintf("/v3/cft/%s/owner", ID), hc.ChangeOwners{
+			_, err := client.POSTContext(ctx, fmt.Sprintf("/v3/cft/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -261,7 +264,7 @@ func resourceAwsCloudformationTemplateUpdate(ctx context.Context, d *schema.Reso
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			err := client.DELETE(fmt.Sprintf("/v3/cft/%s/owner", ID), hc.ChangeOwners{
+			err := client.DELETEContext(ctx, fmt.Sprintf("/v3/cft/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -294,7 +297,7 @@ func resourceAwsCloudformationTemplateDelete(ctx context.Context, d *schema.Reso
 	client := m.(*hc.Client)
 	ID := d.Id()
 
-	err := client.DELETE(fmt.Sprintf("/v3/cft/%s", ID), nil)
+	err := client.DELETEContext(ctx, fmt.Sprintf("/v3/cft/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -105,7 +105,7 @@ func resourceAwsIamPolicyCreate(ctx context.Context, d *schema.ResourceData, m i
 		Policy:            d.Get("policy").(string),
 	}
 
-	resp, err := client.POST("/v3/iam-policy", post)
+	resp, err := client.POSTContext(ctx, "/v3/iam-policy", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -136,8 +136,11 @@ func resourceAwsIamPolicyRead(ctx context.Context, d *schema.ResourceData, m int
 
 	// Use v3 endpoint for single resource lookup
 	resp := new(hc.IAMPolicyResponse)
-	err := client.GET(fmt.Sprintf("/v3/iam-policy/%s", ID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/iam-policy/%s", ID), resp)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_aws_iam_policy", err) {
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read AwsIamPolicy",
@@ -197,7 +200,7 @@ func resourceAwsIamPolicyUpdate(ctx context.Context, d *schema.ResourceData, m i
 			Policy:      d.Get("policy").(string),
 		}
 
-		err := client.PATCH(fmt.Sprintf("/v3/iam-policy/%s", ID), req)
+		err := client.PATCHContext(ctx, fmt.Sprintf("/v3/iam-policy/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -217,7 +220,7 @@ func resourceAwsIamPolicyUpdate(ctx context.Context, d *schema.ResourceData, m i
 
 		if len(arrAddOwnerUserGroupIds) > 0 ||
 			len(arrAddOwnerUserIds) > 0 {
-			_, err := client.POST(fmt.Sprintf("/v3/iam-policy/%s/owner", ID), hc.ChangeOwners{
+			_, err := client.POSTContext(ctx, fmt.Sprintf("/v3/iam-policy/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -233,7 +236,7 @@ func resourceAwsIamPolicyUpdate(ctx context.Context, d *schema.ResourceData, m i
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			err := client.DELETE(fmt.Sprintf("/v3/iam-policy/%s/owner", ID), hc.ChangeOwners{
+			err := client.DELETEContext(ctx, fmt.Sprintf("/v3/iam-policy/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -267,7 +270,7 @@ func resourceAwsIamPolicyDelete(ctx context.Context, d *schema.ResourceData, m i
 	client := m.(*hc.Client)
 	ID := d.Id()
 
-	err := client.DELETE(fmt.Sprintf("/v3/iam-policy/%s", ID), nil)
+	err := client.DELETEContext(ctx, fmt.Sprintf("/v3/iam-policy/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
 	"strconv"
 	"time"
 
@@ -105,9 +104,10 @@ func resourceAzureAccount() *schema.Resource {
 			"labels": {
 				Type:         schema.TypeMap,
 				Optional:     true,
+				Computed:     true,
 				RequiredWith: []string{"project_id"},
 				Elem:         &schema.Schema{Type: schema.TypeString},
-				Description:  "A map of labels to assign to the account. The labels must already exist in Kion.",
+				Description:  "A map of labels to assign to the account. The labels must already exist in Kion unless create_missing_labels is set on the provider.",
 			},
 			"last_updated": {
 				Type:     schema.TypeString,
@@ -218,6 +218,7 @@ func resourceAzureAccount() *schema.Resource {
 			// schema validators don't support multi-attribute validations, so we use CustomizeDiff instead
 			validateAzureAccountStartDatecode,
 			customDiffComputedAccountLocation,
+			hc.AccountDefaultLabelsDiff,
 		),
 	}
 }
@@ -264,7 +265,7 @@ func resourceAzureAccountCreate(ctx context.Context, d *schema.ResourceData, m i
 		if rb, err := json.Marshal(postAccountData); err == nil {
 			tflog.Debug(ctx, fmt.Sprintf("Importing exiting Azure account via POST %s", accountURL), map[string]interface{}{"postData": string(rb)})
 		}
-		resp, err := client.POST(accountURL, postAccountData)
+		resp, err := client.POSTContext(ctx, accountURL, postAccountData)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -334,7 +335,7 @@ func resourceAzureAccountCreate(ctx context.Context, d *schema.ResourceData, m i
 		if rb, err := json.Marshal(postCacheData); err == nil {
 			tflog.Debug(ctx, "Creating new Azure account via POST /v3/account-cache/create?account-type=azure", map[string]interface{}{"postData": string(rb)})
 		}
-		respCache, err := client.POST("/v3/account-cache/create?account-type=azure", postCacheData)
+		respCache, err := client.POSTContext(ctx, "/v3/account-cache/create?account-type=azure", postCacheData)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -357,14 +358,12 @@ func resourceAzureAccountCreate(ctx context.Context, d *schema.ResourceData, m i
 		createStateConf := &retry.StateChangeConf{
 			Refresh: func() (interface{}, string, error) {
 				resp := new(hc.AccountResponse)
-				err := client.GET(fmt.Sprintf("/v3/account-cache/%d", accountCacheID), resp)
+				err := client.GETContext(ctx, fmt.Sprintf("/v3/account-cache/%d", accountCacheID), resp, hc.WithoutCache())
 				if err != nil {
-					if resErr, ok := err.(*hc.RequestError); ok {
-						if resErr.StatusCode == http.StatusNotFound {
-							// StateChangeConf handles 404s differently than errors, so return nil instead of err
-							tflog.Trace(ctx, fmt.Sprintf("Checking new Azure account status: /v3/account-cache/%d not found", accountCacheID))
-							return nil, "NotFound", nil
-						}
+					if errors.Is(err, hc.ErrNotFound) {
+						// StateChangeConf handles 404s differently than errors, so return nil instead of err
+						tflog.Trace(ctx, fmt.Sprintf("Checking new Azure account status: /v3/account-cache/%d not found", accountCacheID))
+						return nil, "NotFound", nil
 					}
 					tflog.Trace(ctx, fmt.Sprintf("Checking new Azure account status: /v3/account-cache/%d error", accountCacheID), map[string]interface{}{"error": err})
 					return nil, "Error", err
@@ -399,7 +398,7 @@ func resourceAzureAccountCreate(ctx context.Context, d *schema.ResourceData, m i
 			projectID := d.Get("project_id").(int)
 			startDatecode := time.Now().Format("200601")
 
-			newID, err := convertCacheAccountToProjectAccount(client, accountCacheID, projectID, startDatecode)
+			newID, err := convertCacheAccountToProjectAccount(ctx, client, accountCacheID, projectID, startDatecode)
 			if err != nil {
 				diags = append(diags, diag.Diagnostic{
 					Severity: diag.Error,
@@ -423,7 +422,7 @@ func resourceAzureAccountCreate(ctx context.Context, d *schema.ResourceData, m i
 	if accountLocation == ProjectLocation {
 		if _, ok := d.GetOk("labels"); ok {
 			ID := d.Id()
-			err := hc.PutAppLabelIDs(client, hc.FlattenAssociateLabels(d, "labels"), "account", ID)
+			err := hc.PutAppLabelIDs(ctx, client, hc.FlattenAssociateLabels(d, "labels"), "account", ID)
 
 			if err != nil {
 				diags = append(diags, diag.Diagnostic{
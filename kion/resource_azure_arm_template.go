@@ -118,7 +118,7 @@ func resourceAzureArmTemplateCreate(ctx context.Context, d *schema.ResourceData,
 		TemplateParameters:    d.Get("template_parameters").(string),
 	}
 
-	resp, err := client.POST("/v3/azure-arm-template", post)
+	resp, err := client.POSTContext(ctx, "/v3/azure-arm-template", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -148,8 +148,11 @@ func resourceAzureArmTemplateRead(ctx context.Context, d *schema.ResourceData, m
 	ID := d.Id()
 
 	resp := new(hc.AzureARMTemplateResponse)
-	err := client.GET(fmt.Sprintf("/v3/azure-arm-template/%s", ID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/azure-arm-template/%s", ID), resp)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_azure_arm_template", err) {
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read Azure ARM Template",
@@ -215,7 +218,7 @@ func resourceAzureArmTemplateUpdate(ctx context.Context, d *schema.ResourceData,
 			TemplateParameters: d.Get("template_parameters").(string),
 		}
 
-		err := client.PATCH(fmt.Sprintf("/v3/azure-arm-template/%s", ID), req)
+		err := client.PATCHContext(ctx, fmt.Sprintf("/v3/azure-arm-template/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -235,7 +238,7 @@ func resourceAzureArmTemplateUpdate(ctx context.Context, d *schema.ResourceData,
 
 		if len(arrAddOwnerUserGroupIds) > 0 ||
 			len(arrAddOwnerUserIds) > 0 {
-			_, err := client.POST(fmt.Sprintf("/v3/azure-arm-template/%s/owner", ID), hc.ChangeOwners{
+			_, err := client.POSTContext(ctx, fmt.Sprintf("/v3/azure-arm-template/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -251,7 +254,7 @@ func resourceAzureArmTemplateUpdate(ctx context.Context, d *schema.ResourceData,
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			err := client.DELETE(fmt.Sprintf("/v3/azure-arm-template/%s/owner", ID), hc.ChangeOwners{
+			err := client.DELETEContext(ctx, fmt.Sprintf("/v3/azure-arm-template/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -285,7 +288,7 @@ func resourceAzureArmTemplateDelete(ctx context.Context, d *schema.ResourceData,
 	client := m.(*hc.Client)
 	ID := d.Id()
 
-	err := client.DELETE(fmt.Sprintf("/v3/azure-arm-template/%s", ID), nil)
+	err := client.DELETEContext(ctx, fmt.Sprintf("/v3/azure-arm-template/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
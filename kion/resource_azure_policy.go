@@ -102,7 +102,7 @@ func resourceAzurePolicyCreate(ctx context.Context, d *schema.ResourceData, m in
 		OwnerUsers:      hc.FlattenGenericIDPointer(d, "owner_users"),
 	}
 
-	resp, err := client.POST("/v3/azure-policy", post)
+	resp, err := client.POSTContext(ctx, "/v3/azure-policy", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -132,8 +132,11 @@ func resourceAzurePolicyRead(ctx context.Context, d *schema.ResourceData, m inte
 	ID := d.Id()
 
 	resp := new(hc.AzurePolicyResponse)
-	err := client.GET(fmt.Sprintf("/v3/azure-policy/%s", ID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/azure-policy/%s", ID), resp)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_azure_policy", err) {
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read AzurePolicy",
@@ -194,7 +197,7 @@ func resourceAzurePolicyUpdate(ctx context.Context, d *schema.ResourceData, m in
 			Policy:      d.Get("policy").(string),
 		}
 
-		err := client.PATCH(fmt.Sprintf("/v3/azure-policy/%s", ID), req)
+		err := client.PATCHContext(ctx, fmt.Sprintf("/v3/azure-policy/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -214,7 +217,7 @@ func resourceAzurePolicyUpdate(ctx context.Context, d *schema.ResourceData, m in
 
 		if len(arrAddOwnerUserGroupIds) > 0 ||
 			len(arrAddOwnerUserIds) > 0 {
-			_, err := client.POST(fmt.Sprintf("/v3/azure-policy/%s/owner", ID), hc.ChangeOwners{
+			_, err := client.POSTContext(ctx, fmt.Sprintf("/v3/azure-policy/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -230,7 +233,7 @@ func resourceAzurePolicyUpdate(ctx context.Context, d *schema.ResourceData, m in
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			err := client.DELETE(fmt.Sprintf("/v3/azure-policy/%s/owner", ID), hc.ChangeOwners{
+			err := client.DELETEContext(ctx, fmt.Sprintf("/v3/azure-policy/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -264,7 +267,7 @@ func resourceAzurePolicyDelete(ctx context.Context, d *schema.ResourceData, m in
 	client := m.(*hc.Client)
 	ID := d.Id()
 
-	err := client.DELETE(fmt.Sprintf("/v3/azure-policy/%s", ID), nil)
+	err := client.DELETEContext(ctx, fmt.Sprintf("/v3/azure-policy/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
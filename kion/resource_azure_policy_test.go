@@ -1,8 +1,8 @@
 package kion
 
 import (
+	"errors"
 	"fmt"
-	"net/http"
 	"strings"
 	"testing"
 
@@ -183,7 +183,7 @@ func testAccAzurePolicyCheckResourceDestroy(s *terraform.State) error {
 			return nil
 		}
 
-		if !strings.Contains(err.Error(), fmt.Sprintf("status: %d", http.StatusNotFound)) {
+		if !errors.Is(err, hc.ErrNotFound) {
 			return err
 		}
 	}
@@ -111,7 +111,7 @@ func resourceAzureRoleCreate(ctx context.Context, d *schema.ResourceData, m inte
 		RolePermissions:   d.Get("role_permissions").(string),
 	}
 
-	resp, err := client.POST("/v3/azure-role", post)
+	resp, err := client.POSTContext(ctx, "/v3/azure-role", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -141,8 +141,11 @@ func resourceAzureRoleRead(ctx context.Context, d *schema.ResourceData, m interf
 	ID := d.Id()
 
 	resp := new(hc.AzureRoleResponse)
-	err := client.GET(fmt.Sprintf("/v3/azure-role/%s", ID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/azure-role/%s", ID), resp)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_azure_role", err) {
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read AzureRole",
@@ -200,7 +203,7 @@ func resourceAzureRoleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 			RolePermissions: d.Get("role_permissions").(string),
 		}
 
-		err := client.PATCH(fmt.Sprintf("/v3/azure-role/%s", ID), req)
+		err := client.PATCHContext(ctx, fmt.Sprintf("/v3/azure-role/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -220,7 +223,7 @@ func resourceAzureRoleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 		if len(arrAddOwnerUserGroupIds) > 0 ||
 			len(arrAddOwnerUserIds) > 0 {
-			_, err := client.POST(fmt.Sprintf("/v3/azure-role/%s/owner", ID), hc.ChangeOwners{
+			_, err := client.POSTContext(ctx, fmt.Sprintf("/v3/azure-role/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -236,7 +239,7 @@ func resourceAzureRoleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			err := client.DELETE(fmt.Sprintf("/v3/azure-role/%s/owner", ID), hc.ChangeOwners{
+			err := client.DELETEContext(ctx, fmt.Sprintf("/v3/azure-role/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -270,7 +273,7 @@ func resourceAzureRoleDelete(ctx context.Context, d *schema.ResourceData, m inte
 	client := m.(*hc.Client)
 	ID := d.Id()
 
-	err := client.DELETE(fmt.Sprintf("/v3/azure-role/%s", ID), nil)
+	err := client.DELETEContext(ctx, fmt.Sprintf("/v3/azure-role/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
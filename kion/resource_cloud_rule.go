@@ -24,6 +24,7 @@ func resourceCloudRule() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: hc.DefaultLabelsDiff,
 		Schema: map[string]*schema.Schema{
 			// Notice there is no 'id' field specified because it will be created.
 			"last_updated": {
@@ -226,8 +227,9 @@ func resourceCloudRule() *schema.Resource {
 			"labels": {
 				Type:        schema.TypeMap,
 				Optional:    true,
+				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
-				Description: "A map of labels to assign to the cloud rule. The labels must already exist in Kion.",
+				Description: "A map of labels to assign to the cloud rule. The labels must already exist in Kion unless create_missing_labels is set on the provider.",
 			},
 			"concurrent_cft_sync": {
 				Type:        schema.TypeBool,
@@ -281,7 +283,7 @@ func resourceCloudRuleCreate(ctx context.Context, d *schema.ResourceData, m inte
 		ServiceControlPolicyIds:       hc.FlattenGenericIDPointer(d, "service_control_policies"),
 	}
 
-	resp, err := client.POST("/v3/cloud-rule", post)
+	resp, err := client.POSTContext(ctx, "/v3/cloud-rule", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -302,7 +304,7 @@ func resourceCloudRuleCreate(ctx context.Context, d *schema.ResourceData, m inte
 
 	if labels, ok := d.GetOk("labels"); ok && labels != nil {
 		ID := d.Id()
-		err = hc.PutAppLabelIDs(client, hc.FlattenAssociateLabels(d, "labels"), "cloud-rule", ID)
+		err = hc.PutAppLabelIDs(ctx, client, hc.FlattenAssociateLabels(d, "labels"), "cloud-rule", ID)
 
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
@@ -325,8 +327,11 @@ func resourceCloudRuleRead(ctx context.Context, d *schema.ResourceData, m interf
 	ID := d.Id()
 
 	resp := new(hc.CloudRuleResponse)
-	err := client.GET(fmt.Sprintf("/v3/cloud-rule/%s", ID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/cloud-rule/%s", ID), resp)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_cloud_rule", err) {
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read CloudRule",
@@ -404,7 +409,7 @@ func resourceCloudRuleRead(ctx context.Context, d *schema.ResourceData, m interf
 	}
 
 	// Fetch labels
-	labelData, err := hc.ReadResourceLabels(client, "cloud-rule", ID)
+	labelData, err := hc.ReadResourceLabels(ctx, client, "cloud-rule", ID)
 
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
@@ -449,7 +454,7 @@ func resourceCloudRuleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 			PostWebhookID:     hc.FlattenIntPointer(d, "post_webhook_id"),
 			PreWebhookID:      hc.FlattenIntPointer(d, "pre_webhook_id"),
 		}
-		if err := client.PATCH(fmt.Sprintf("/v3/cloud-rule/%s", ID), req); err != nil {
+		if err := client.PATCHContext(ctx, fmt.Sprintf("/v3/cloud-rule/%s", ID), req); err != nil {
 			return append(diags, diag.Diagnostic{
 				Severity: diag.Error,
 				Summary:  "Unable to update CloudRule",
@@ -462,7 +467,7 @@ func resourceCloudRuleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	if d.HasChange("aws_cloudformation_templates") {
 		newCftIDs := extractCFTandARMTemplateIDs(d, "aws_cloudformation_templates")
 		if len(newCftIDs) > 0 {
-			if err := updateCFTandARMTemplateAssociations(client, ID, newCftIDs, "CFT"); err != nil {
+			if err := updateCFTandARMTemplateAssociations(ctx, client, ID, newCftIDs, "CFT"); err != nil {
 				return append(diags, err...)
 			}
 		}
@@ -472,7 +477,7 @@ func resourceCloudRuleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	if d.HasChange("azure_arm_template_definitions") {
 		newArmTemplateIDs := extractCFTandARMTemplateIDs(d, "azure_arm_template_definitions")
 		if len(newArmTemplateIDs) > 0 {
-			if err := updateCFTandARMTemplateAssociations(client, ID, newArmTemplateIDs, "ARM"); err != nil {
+			if err := updateCFTandARMTemplateAssociations(ctx, client, ID, newArmTemplateIDs, "ARM"); err != nil {
 				return append(diags, err...)
 			}
 		}
@@ -517,7 +522,7 @@ func resourceCloudRuleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 			len(arrAddOUIds) > 0 ||
 			len(arrAddProjectIds) > 0 ||
 			len(arrAddServiceControlPolicyIds) > 0 {
-			_, err := client.POST(fmt.Sprintf("/v3/cloud-rule/%s/association", ID), hc.CloudRuleAssociationsAdd{
+			_, err := client.POSTContext(ctx, fmt.Sprintf("/v3/cloud-rule/%s/association", ID), hc.CloudRuleAssociationsAdd{
 				AzurePolicyDefinitionIds: &arrAddAzurePolicyDefinitionIds,
 				AzureRoleDefinitionIds:   &arrAddAzureRoleDefinitionIds,
 				ComplianceStandardIds:    &arrAddComplianceStandardIds,
@@ -551,7 +556,7 @@ func resourceCloudRuleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 			len(arrRemoveOUIds) > 0 ||
 			len(arrRemoveProjectIds) > 0 ||
 			len(arrRemoveServiceControlPolicyIds) > 0 {
-			err := client.DELETE(fmt.Sprintf("/v3/cloud-rule/%s/association", ID), hc.CloudRuleAssociationsRemove{
+			err := client.DELETEContext(ctx, fmt.Sprintf("/v3/cloud-rule/%s/association", ID), hc.CloudRuleAssociationsRemove{
 				AzureArmTemplateDefinitionIds: &arrRemoveAzureArmTemplateDefinitionIds,
 				AzurePolicyDefinitionIds:      &arrRemoveAzurePolicyDefinitionIds,
 				AzureRoleDefinitionIds:        &arrRemoveAzureRoleDefinitionIds,
@@ -585,7 +590,7 @@ func resourceCloudRuleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 		if len(arrAddOwnerUserGroupIds) > 0 ||
 			len(arrAddOwnerUserIds) > 0 {
-			_, err := client.POST(fmt.Sprintf("/v3/cloud-rule/%s/owner", ID), hc.ChangeOwners{
+			_, err := client.POSTContext(ctx, fmt.Sprintf("/v3/cloud-rule/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -601,7 +606,7 @@ func resourceCloudRuleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			err := client.DELETE(fmt.Sprintf("/v3/cloud-rule/%s/owner", ID), hc.ChangeOwners{
+			err := client.DELETEContext(ctx, fmt.Sprintf("/v3/cloud-rule/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -619,7 +624,7 @@ func resourceCloudRuleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	if d.HasChanges("labels") {
 		hasChanged++
 
-		err := hc.PutAppLabelIDs(client, hc.FlattenAssociateLabels(d, "labels"), "cloud-rule", ID)
+		err := hc.PutAppLabelIDs(ctx, client, hc.FlattenAssociateLabels(d, "labels"), "cloud-rule", ID)
 
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
@@ -650,7 +655,7 @@ func resourceCloudRuleDelete(ctx context.Context, d *schema.ResourceData, m inte
 	client := m.(*hc.Client)
 	ID := d.Id()
 
-	err := client.DELETE(fmt.Sprintf("/v3/cloud-rule/%s", ID), nil)
+	err := client.DELETEContext(ctx, fmt.Sprintf("/v3/cloud-rule/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -659,6 +664,7 @@ func resourceCloudRuleDelete(ctx context.Context, d *schema.ResourceData, m inte
 		})
 		return diags
 	}
+	diags = append(diags, hc.DeleteUnusedResourceLabels(ctx, client, d)...)
 
 	// d.SetId("") is automatically called assuming delete returns no errors, but
 	// it is added here for explicitness.
@@ -677,7 +683,7 @@ func extractCFTandARMTemplateIDs(d *schema.ResourceData, key string) []int {
 	return ids
 }
 
-func updateCFTandARMTemplateAssociations(client *hc.Client, ID string, ids []int, templateType string) diag.Diagnostics {
+func updateCFTandARMTemplateAssociations(ctx context.Context, client *hc.Client, ID string, ids []int, templateType string) diag.Diagnostics {
 	var diags diag.Diagnostics
 	cloudRuleAssocationEndpoint := fmt.Sprintf("/v3/cloud-rule/%s/association", ID)
 	reqBody := hc.CloudRuleAssociationsAdd{}
@@ -686,7 +692,7 @@ func updateCFTandARMTemplateAssociations(client *hc.Client, ID string, ids []int
 	} else {
 		reqBody.AzureArmTemplateDefinitionIds = &ids
 	}
-	if _, err := client.POST(cloudRuleAssocationEndpoint, reqBody); err != nil {
+	if _, err := client.POSTContext(ctx, cloudRuleAssocationEndpoint, reqBody); err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  fmt.Sprintf("Unable to update %s templates association", templateType),
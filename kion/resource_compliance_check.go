@@ -152,7 +152,7 @@ func resourceComplianceCheckCreate(ctx context.Context, d *schema.ResourceData,
 		SeverityTypeID:        hc.FlattenIntPointer(d, "severity_type_id"),
 	}
 
-	resp, err := client.POST("/v3/compliance/check", post)
+	resp, err := client.POSTContext(ctx, "/v3/compliance/check", post)
 	if err != nil {
 		return diag.FromErr(err)
 	} else if resp.RecordID == 0 {
@@ -169,7 +169,10 @@ func resourceComplianceCheckRead(ctx context.Context, d *schema.ResourceData, m
 	ID := d.Id()
 
 	resp := new(hc.ComplianceCheckWithOwnersResponse)
-	if err := client.GET(fmt.Sprintf("/v3/compliance/check/%s", ID), resp); err != nil {
+	if err := client.GETContext(ctx, fmt.Sprintf("/v3/compliance/check/%s", ID), resp); err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_compliance_check", err) {
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 	item := resp.Data
@@ -236,7 +239,7 @@ func resourceComplianceCheckUpdate(ctx context.Context, d *schema.ResourceData,
 			SeverityTypeID:        hc.FlattenIntPointer(d, "severity_type_id"),
 		}
 
-		if err := client.PATCH(fmt.Sprintf("/v3/compliance/check/%s", ID), req); err != nil {
+		if err := client.PATCHContext(ctx, fmt.Sprintf("/v3/compliance/check/%s", ID), req); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -255,7 +258,7 @@ func resourceComplianceCheckUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 
 		if len(arrAddOwnerUserGroupIds) > 0 || len(arrAddOwnerUserIds) > 0 {
-			_, err := client.POST(fmt.Sprintf("/v3/compliance/check/%s/owner", ID), hc.ChangeOwners{
+			_, err := client.POSTContext(ctx, fmt.Sprintf("/v3/compliance/check/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -265,7 +268,7 @@ func resourceComplianceCheckUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 || len(arrRemoveOwnerUserIds) > 0 {
-			err := client.DELETE(fmt.Sprintf("/v3/compliance/check/%s/owner", ID), hc.ChangeOwners{
+			err := client.DELETEContext(ctx, fmt.Sprintf("/v3/compliance/check/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -288,7 +291,7 @@ func resourceComplianceCheckDelete(ctx context.Context, d *schema.ResourceData,
 	client := m.(*hc.Client)
 	ID := d.Id()
 
-	if err := client.DELETE(fmt.Sprintf("/v3/compliance/check/%s", ID), nil); err != nil {
+	if err := client.DELETEContext(ctx, fmt.Sprintf("/v3/compliance/check/%s", ID), nil); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -109,7 +109,7 @@ func resourceComplianceStandardCreate(ctx context.Context, d *schema.ResourceDat
 		OwnerUserIds:       hc.FlattenGenericIDPointer(d, "owner_users"),
 	}
 
-	resp, err := client.POST("/v3/compliance/standard", post)
+	resp, err := client.POSTContext(ctx, "/v3/compliance/standard", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -139,8 +139,11 @@ func resourceComplianceStandardRead(ctx context.Context, d *schema.ResourceData,
 	ID := d.Id()
 
 	resp := new(hc.ComplianceStandardResponse)
-	err := client.GET(fmt.Sprintf("/v3/compliance/standard/%s", ID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/compliance/standard/%s", ID), resp)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_compliance_standard", err) {
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read ComplianceStandard",
@@ -194,7 +197,7 @@ func resourceComplianceStandardUpdate(ctx context.Context, d *schema.ResourceDat
 			Name:        d.Get("name").(string),
 		}
 
-		if err := client.PATCH(fmt.Sprintf("/v3/compliance/standard/%s", ID), req); err != nil {
+		if err := client.PATCHContext(ctx, fmt.Sprintf("/v3/compliance/standard/%s", ID), req); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -205,7 +208,7 @@ func resourceComplianceStandardUpdate(ctx context.Context, d *schema.ResourceDat
 
 		// Get current state before making changes
 		resp := new(hc.ComplianceStandardResponse)
-		if err := client.GET(fmt.Sprintf("/v3/compliance/standard/%s", ID), resp); err != nil {
+		if err := client.GETContext(ctx, fmt.Sprintf("/v3/compliance/standard/%s", ID), resp); err != nil {
 			return diag.FromErr(err)
 		}
 
@@ -230,7 +233,7 @@ func resourceComplianceStandardUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 
 		if len(arrAddComplianceCheckIds) > 0 {
-			_, err := client.POST(fmt.Sprintf("/v3/compliance/standard/%s/association", ID), hc.ComplianceStandardAssociationsAdd{
+			_, err := client.POSTContext(ctx, fmt.Sprintf("/v3/compliance/standard/%s/association", ID), hc.ComplianceStandardAssociationsAdd{
 				ComplianceCheckIds: &arrAddComplianceCheckIds,
 			})
 			if err != nil {
@@ -239,7 +242,7 @@ func resourceComplianceStandardUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 
 		if len(validRemoveChecks) > 0 {
-			err := client.DELETE(fmt.Sprintf("/v3/compliance/standard/%s/association", ID), hc.ComplianceStandardAssociationsRemove{
+			err := client.DELETEContext(ctx, fmt.Sprintf("/v3/compliance/standard/%s/association", ID), hc.ComplianceStandardAssociationsRemove{
 				ComplianceCheckIds: validRemoveChecks,
 			})
 			if err != nil {
@@ -266,7 +269,7 @@ func resourceComplianceStandardUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 
 		if len(arrAddOwnerUserGroupIds) > 0 || len(arrAddOwnerUserIds) > 0 {
-			_, err := client.POST(fmt.Sprintf("/v3/compliance/standard/%s/owner", ID), hc.ChangeOwners{
+			_, err := client.POSTContext(ctx, fmt.Sprintf("/v3/compliance/standard/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -276,7 +279,7 @@ func resourceComplianceStandardUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 || len(arrRemoveOwnerUserIds) > 0 {
-			err := client.DELETE(fmt.Sprintf("/v3/compliance/standard/%s/owner", ID), hc.ChangeOwners{
+			err := client.DELETEContext(ctx, fmt.Sprintf("/v3/compliance/standard/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -300,7 +303,7 @@ func resourceComplianceStandardDelete(ctx context.Context, d *schema.ResourceDat
 	client := m.(*hc.Client)
 	ID := d.Id()
 
-	err := client.DELETE(fmt.Sprintf("/v3/compliance/standard/%s", ID), nil)
+	err := client.DELETEContext(ctx, fmt.Sprintf("/v3/compliance/standard/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -62,9 +62,10 @@ func resourceCustomAccount() *schema.Resource {
 			"labels": {
 				Type:         schema.TypeMap,
 				Optional:     true,
+				Computed:     true,
 				RequiredWith: []string{"project_id"},
 				Elem:         &schema.Schema{Type: schema.TypeString},
-				Description:  "A map of labels to assign to the account. The labels must already exist in Kion.",
+				Description:  "A map of labels to assign to the account. The labels must already exist in Kion unless create_missing_labels is set on the provider.",
 			},
 			"last_updated": {
 				Type:     schema.TypeString,
@@ -111,6 +112,7 @@ func resourceCustomAccount() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			validateCustomAccountStartDatecode,
 			customDiffComputedAccountLocation,
+			hc.AccountDefaultLabelsDiff,
 		),
 	}
 }
@@ -164,9 +166,9 @@ func resourceCustomAccountCreate(ctx context.Context, d *schema.ResourceData, m
 		"url": accountURL,
 	})
 
-	resp, err := client.POST(accountURL, postAccountData)
+	resp, err := client.POSTContext(ctx, accountURL, postAccountData)
 	if err != nil {
-		diags = append(diags, hc.HandleError(fmt.Errorf("unable to import custom account: %v", err))...)
+		diags = append(diags, hc.HandleError(fmt.Errorf("unable to import custom account: %w", err))...)
 		return diags
 	}
 
@@ -176,8 +178,8 @@ func resourceCustomAccountCreate(ctx context.Context, d *schema.ResourceData, m
 	if accountLocation == ProjectLocation {
 		if _, ok := d.GetOk("labels"); ok {
 			ID := d.Id()
-			if err := hc.PutAppLabelIDs(client, hc.FlattenAssociateLabels(d, "labels"), "account", ID); err != nil {
-				return append(diags, hc.HandleError(fmt.Errorf("unable to update custom account labels (ID: %s): %v", ID, err))...)
+			if err := hc.PutAppLabelIDs(ctx, client, hc.FlattenAssociateLabels(d, "labels"), "account", ID); err != nil {
+				return append(diags, hc.HandleError(fmt.Errorf("unable to update custom account labels (ID: %s): %w", ID, err))...)
 			}
 		}
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,10 +19,7 @@ func resourceCustomVariableOverride() *schema.Resource {
 		UpdateContext: resourceCustomVariableOverrideUpdate,
 		DeleteContext: resourceCustomVariableOverrideDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-				resourceCustomVariableOverrideRead(ctx, d, m)
-				return []*schema.ResourceData{d}, nil
-			},
+			StateContext: resourceCustomVariableOverrideImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"value_string": {
@@ -71,9 +69,9 @@ func resourceCustomVariableOverrideCreate(ctx context.Context, d *schema.Resourc
 	// Get the custom variable type first
 	cvID := d.Get("custom_variable_id").(string)
 	cvResp := new(hc.CustomVariableResponse)
-	err := client.GET(fmt.Sprintf("/v3/custom-variable/%s", cvID), cvResp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/custom-variable/%s", cvID), cvResp)
 	if err != nil {
-		return hc.HandleError(fmt.Errorf("failed to get custom variable type: %v", err))
+		return hc.HandleError(fmt.Errorf("failed to get custom variable type: %w", err))
 	}
 
 	// Get the appropriate value based on type
@@ -95,7 +93,7 @@ func resourceCustomVariableOverrideCreate(ctx context.Context, d *schema.Resourc
 
 	cvValue, err := hc.UnpackCvValueJSONStr(value, cvResp.Data.Type)
 	if err != nil {
-		return hc.HandleError(fmt.Errorf("failed to process value: %v", err))
+		return hc.HandleError(fmt.Errorf("failed to process value: %w", err))
 	}
 
 	data := hc.CustomVariableOverrideSet{
@@ -105,9 +103,9 @@ func resourceCustomVariableOverrideCreate(ctx context.Context, d *schema.Resourc
 	entityType := d.Get("entity_type").(string)
 	entityID := d.Get("entity_id").(string)
 
-	err = client.PUT(fmt.Sprintf("/v3/%s/%s/custom-variable/%s", entityType, entityID, cvID), data)
+	err = client.PUTContext(ctx, fmt.Sprintf("/v3/%s/%s/custom-variable/%s", entityType, entityID, cvID), data)
 	if err != nil {
-		return hc.HandleError(fmt.Errorf("unable to create CustomVariable Override: %v", err))
+		return hc.HandleError(fmt.Errorf("unable to create CustomVariable Override: %w", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", entityType, entityID, cvID))
@@ -125,23 +123,35 @@ func resourceCustomVariableOverrideRead(ctx context.Context, d *schema.ResourceD
 
 	// Get the custom variable type first
 	cvResp := new(hc.CustomVariableResponse)
-	err := client.GET(fmt.Sprintf("/v3/custom-variable/%s", cvID), cvResp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/custom-variable/%s", cvID), cvResp)
 	if err != nil {
-		return hc.HandleError(fmt.Errorf("failed to get custom variable type: %v", err))
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_custom_variable_override", err) {
+			return diags
+		}
+		return hc.HandleError(fmt.Errorf("failed to get custom variable type: %w", err))
 	}
 
 	resp := new(hc.CustomVariableOverrideResponse)
-	err = client.GET(fmt.Sprintf("/v3/%s/%s/custom-variable/%s", entityType, entityID, cvID), resp)
+	err = client.GETContext(ctx, fmt.Sprintf("/v3/%s/%s/custom-variable/%s", entityType, entityID, cvID), resp)
 	if err != nil {
-		return hc.HandleError(fmt.Errorf("unable to read CustomVariable Override: %v", err))
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_custom_variable_override", err) {
+			return diags
+		}
+		return hc.HandleError(fmt.Errorf("unable to read CustomVariable Override: %w", err))
 	}
 	item := resp.Data
 
+	// The override was cleared outside of Terraform.
+	if item.Override == nil {
+		hc.RemoveFromState(ctx, d, "kion_custom_variable_override")
+		return diags
+	}
+
 	// Only process if there's an override value
-	if item.Override != nil && item.Override.Value != nil {
+	if item.Override.Value != nil {
 		cvValueStr, err := hc.PackCvValueIntoJSONStr(item.Override.Value, cvResp.Data.Type)
 		if err != nil {
-			return hc.HandleError(fmt.Errorf("failed to process value: %v", err))
+			return hc.HandleError(fmt.Errorf("failed to process value: %w", err))
 		}
 
 		// Set the appropriate value based on type
@@ -183,9 +193,9 @@ func resourceCustomVariableOverrideUpdate(ctx context.Context, d *schema.Resourc
 		// Get the custom variable type first
 		cvID := d.Get("custom_variable_id").(string)
 		cvResp := new(hc.CustomVariableResponse)
-		err := client.GET(fmt.Sprintf("/v3/custom-variable/%s", cvID), cvResp)
+		err := client.GETContext(ctx, fmt.Sprintf("/v3/custom-variable/%s", cvID), cvResp)
 		if err != nil {
-			return hc.HandleError(fmt.Errorf("failed to get custom variable type: %v", err))
+			return hc.HandleError(fmt.Errorf("failed to get custom variable type: %w", err))
 		}
 
 		// Get the appropriate value based on type
@@ -207,7 +217,7 @@ func resourceCustomVariableOverrideUpdate(ctx context.Context, d *schema.Resourc
 
 		cvValue, err := hc.UnpackCvValueJSONStr(value, cvResp.Data.Type)
 		if err != nil {
-			return hc.HandleError(fmt.Errorf("failed to process value: %v", err))
+			return hc.HandleError(fmt.Errorf("failed to process value: %w", err))
 		}
 
 		entityType := d.Get("entity_type").(string)
@@ -217,9 +227,9 @@ func resourceCustomVariableOverrideUpdate(ctx context.Context, d *schema.Resourc
 			Value: cvValue,
 		}
 
-		err = client.PUT(fmt.Sprintf("/v3/%s/%s/custom-variable/%s", entityType, entityID, cvID), req)
+		err = client.PUTContext(ctx, fmt.Sprintf("/v3/%s/%s/custom-variable/%s", entityType, entityID, cvID), req)
 		if err != nil {
-			return hc.HandleError(fmt.Errorf("unable to update CustomVariable Override: %v", err))
+			return hc.HandleError(fmt.Errorf("unable to update CustomVariable Override: %w", err))
 		}
 
 		diags := hc.SafeSet(d, "last_updated", time.Now().Format(time.RFC850), "Failed to set last_updated")
@@ -238,12 +248,29 @@ func resourceCustomVariableOverrideDelete(ctx context.Context, d *schema.Resourc
 	entityID := d.Get("entity_id").(string)
 	cvID := d.Get("custom_variable_id").(string)
 
-	err := client.DELETE(fmt.Sprintf("/v3/%s/%s/custom-variable/%s", entityType, entityID, cvID), nil)
+	err := client.DELETEContext(ctx, fmt.Sprintf("/v3/%s/%s/custom-variable/%s", entityType, entityID, cvID), nil)
 	if err != nil {
-		return hc.HandleError(fmt.Errorf("unable to delete CustomVariable Override: %v", err))
+		return hc.HandleError(fmt.Errorf("unable to delete CustomVariable Override: %w", err))
 	}
 
 	d.SetId("")
 
 	return nil
 }
+
+// resourceCustomVariableOverrideImport sets the attributes the read depends
+// on from an import ID of the form entity_type/entity_id/custom_variable_id.
+func resourceCustomVariableOverrideImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected entity_type/entity_id/custom_variable_id", d.Id())
+	}
+
+	for i, key := range []string{"entity_type", "entity_id", "custom_variable_id"} {
+		if err := d.Set(key, parts[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -96,11 +96,11 @@ func resourceCustomVariableCreate(ctx context.Context, d *schema.ResourceData, m
 
 	ownerUserIDs, err := hc.ConvertInterfaceSliceToIntSlice(d.Get("owner_user_ids").(*schema.Set).List())
 	if err != nil {
-		return hc.HandleError(fmt.Errorf("failed to convert owner_user_ids: %v", err))
+		return hc.HandleError(fmt.Errorf("failed to convert owner_user_ids: %w", err))
 	}
 	ownerUserGroupIDs, err := hc.ConvertInterfaceSliceToIntSlice(d.Get("owner_user_group_ids").(*schema.Set).List())
 	if err != nil {
-		return hc.HandleError(fmt.Errorf("failed to convert owner_user_group_ids: %v", err))
+		return hc.HandleError(fmt.Errorf("failed to convert owner_user_group_ids: %w", err))
 	}
 
 	cvType := d.Get("type").(string)
@@ -123,7 +123,7 @@ func resourceCustomVariableCreate(ctx context.Context, d *schema.ResourceData, m
 
 	cvValue, err := hc.UnpackCvValueJSONStr(defaultValue, cvType)
 	if err != nil {
-		return hc.HandleError(fmt.Errorf("failed to process default_value: %v", err))
+		return hc.HandleError(fmt.Errorf("failed to process default_value: %w", err))
 	}
 
 	post := hc.CustomVariableCreate{
@@ -139,9 +139,9 @@ func resourceCustomVariableCreate(ctx context.Context, d *schema.ResourceData, m
 		OwnerUserGroupIDs:      ownerUserGroupIDs,
 	}
 
-	resp, err := client.POST("/v3/custom-variable", post)
+	resp, err := client.POSTContext(ctx, "/v3/custom-variable", post)
 	if err != nil {
-		return hc.HandleError(fmt.Errorf("unable to create CustomVariable: %v", err))
+		return hc.HandleError(fmt.Errorf("unable to create CustomVariable: %w", err))
 	} else if resp.RecordID == 0 {
 		return hc.HandleError(fmt.Errorf("received item ID of 0"))
 	}
@@ -157,16 +157,19 @@ func resourceCustomVariableRead(ctx context.Context, d *schema.ResourceData, m i
 	ID := d.Id()
 
 	resp := new(hc.CustomVariableResponse)
-	err := client.GET(fmt.Sprintf("/v3/custom-variable/%s", ID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/custom-variable/%s", ID), resp)
 	if err != nil {
-		return hc.HandleError(fmt.Errorf("unable to read CustomVariable: %v", err))
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_custom_variable", err) {
+			return diags
+		}
+		return hc.HandleError(fmt.Errorf("unable to read CustomVariable: %w", err))
 	}
 	item := resp.Data
 
 	cvType := item.Type
 	cvValueStr, err := hc.PackCvValueIntoJSONStr(item.DefaultValue, cvType)
 	if err != nil {
-		return hc.HandleError(fmt.Errorf("failed to process default_value: %v", err))
+		return hc.HandleError(fmt.Errorf("failed to process default_value: %w", err))
 	}
 
 	switch cvType {
@@ -215,11 +218,11 @@ func resourceCustomVariableUpdate(ctx context.Context, d *schema.ResourceData, m
 
 		ownerUserIDs, err := hc.ConvertInterfaceSliceToIntSlice(d.Get("owner_user_ids").(*schema.Set).List())
 		if err != nil {
-			return hc.HandleError(fmt.Errorf("failed to convert owner_user_ids: %v", err))
+			return hc.HandleError(fmt.Errorf("failed to convert owner_user_ids: %w", err))
 		}
 		ownerUserGroupIDs, err := hc.ConvertInterfaceSliceToIntSlice(d.Get("owner_user_group_ids").(*schema.Set).List())
 		if err != nil {
-			return hc.HandleError(fmt.Errorf("failed to convert owner_user_group_ids: %v", err))
+			return hc.HandleError(fmt.Errorf("failed to convert owner_user_group_ids: %w", err))
 		}
 
 		cvType := d.Get("type").(string)
@@ -242,7 +245,7 @@ func resourceCustomVariableUpdate(ctx context.Context, d *schema.ResourceData, m
 
 		cvValue, err := hc.UnpackCvValueJSONStr(defaultValue, cvType)
 		if err != nil {
-			return hc.HandleError(fmt.Errorf("failed to process default_value: %v", err))
+			return hc.HandleError(fmt.Errorf("failed to process default_value: %w", err))
 		}
 
 		req := hc.CustomVariableUpdate{
@@ -256,9 +259,9 @@ func resourceCustomVariableUpdate(ctx context.Context, d *schema.ResourceData, m
 			OwnerUserGroupIDs:      ownerUserGroupIDs,
 		}
 
-		err = client.PUT(fmt.Sprintf("/v3/custom-variable/%s", ID), req)
+		err = client.PUTContext(ctx, fmt.Sprintf("/v3/custom-variable/%s", ID), req)
 		if err != nil {
-			return hc.HandleError(fmt.Errorf("unable to update CustomVariable: %v", err))
+			return hc.HandleError(fmt.Errorf("unable to update CustomVariable: %w", err))
 		}
 
 		diags := hc.SafeSet(d, "last_updated", time.Now().Format(time.RFC850), "Failed to set last_updated")
@@ -274,9 +277,9 @@ func resourceCustomVariableDelete(ctx context.Context, d *schema.ResourceData, m
 	client := m.(*hc.Client)
 	ID := d.Id()
 
-	err := client.DELETE(fmt.Sprintf("/v3/custom-variable/%s", ID), nil)
+	err := client.DELETEContext(ctx, fmt.Sprintf("/v3/custom-variable/%s", ID), nil)
 	if err != nil {
-		return hc.HandleError(fmt.Errorf("unable to delete CustomVariable: %v", err))
+		return hc.HandleError(fmt.Errorf("unable to delete CustomVariable: %w", err))
 	}
 
 	d.SetId("")
@@ -23,6 +23,7 @@ func resourceFundingSource() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: hc.DefaultLabelsDiff,
 		Schema: map[string]*schema.Schema{
 			// Notice there is no 'id' field specified because it will be created.
 			"last_updated": {
@@ -89,8 +90,9 @@ func resourceFundingSource() *schema.Resource {
 			"labels": {
 				Type:        schema.TypeMap,
 				Optional:    true,
+				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
-				Description: "A map of labels to assign to the funding source. The labels must already exist in Kion.",
+				Description: "A map of labels to assign to the funding source. The labels must already exist in Kion unless create_missing_labels is set on the provider.",
 			},
 		},
 	}
@@ -116,7 +118,7 @@ func resourceFundingSourceCreate(ctx context.Context, d *schema.ResourceData, m
 		OwnerUserGroupIds:  hc.FlattenGenericIDPointer(d, "owner_user_groups"),
 	}
 
-	resp, err := client.POST("/v3/funding-source", post)
+	resp, err := client.POSTContext(ctx, "/v3/funding-source", post)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to create Funding Source: %v", err))
 	} else if resp.RecordID == 0 {
@@ -127,7 +129,7 @@ func resourceFundingSourceCreate(ctx context.Context, d *schema.ResourceData, m
 
 	if labels, ok := d.GetOk("labels"); ok && labels != nil {
 		ID := d.Id()
-		err = hc.PutAppLabelIDs(client, hc.FlattenAssociateLabels(d, "labels"), "funding-source", ID)
+		err = hc.PutAppLabelIDs(ctx, client, hc.FlattenAssociateLabels(d, "labels"), "funding-source", ID)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("unable to update Funding Source labels: %v", err))
 		}
@@ -141,8 +143,11 @@ func resourceFundingSourceRead(ctx context.Context, d *schema.ResourceData, m in
 	ID := d.Id()
 
 	resp := new(hc.FundingSourceResponse)
-	err := client.GET(fmt.Sprintf("/v3/funding-source/%s", ID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/funding-source/%s", ID), resp)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_funding_source", err) {
+			return nil
+		}
 		return diag.FromErr(fmt.Errorf("unable to read Funding Source: %v", err))
 	}
 	item := resp.Data
@@ -152,6 +157,7 @@ func resourceFundingSourceRead(ctx context.Context, d *schema.ResourceData, m in
 	data["description"] = item.Description
 	data["name"] = item.Name
 	data["ou_id"] = item.OUID
+	data["permission_scheme_id"] = item.PermissionSchemeID
 	data["start_datecode"] = item.StartDatecode
 	data["end_datecode"] = item.EndDatecode
 
@@ -162,7 +168,7 @@ func resourceFundingSourceRead(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	permissionResp := new(hc.FSUserMappingListResponse)
-	err = client.GET(fmt.Sprintf("/v3/funding-source/%s/permission-mapping", ID), permissionResp)
+	err = client.GETContext(ctx, fmt.Sprintf("/v3/funding-source/%s/permission-mapping", ID), permissionResp)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to read Funding Source permissions: %v", err))
 	}
@@ -183,7 +189,7 @@ func resourceFundingSourceRead(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	// Fetch and set labels
-	labelData, err := hc.ReadResourceLabels(client, "funding-source", ID)
+	labelData, err := hc.ReadResourceLabels(ctx, client, "funding-source", ID)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to read funding source labels: %v", err))
 	}
@@ -215,7 +221,7 @@ func resourceFundingSourceUpdate(ctx context.Context, d *schema.ResourceData, m
 			OUID:          ouID,
 		}
 
-		err := client.PATCH(fmt.Sprintf("/v3/funding-source/%s", ID), req)
+		err := client.PATCHContext(ctx, fmt.Sprintf("/v3/funding-source/%s", ID), req)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("unable to update Funding Source: %v", err))
 		}
@@ -235,7 +241,7 @@ func resourceFundingSourceUpdate(ctx context.Context, d *schema.ResourceData, m
 				},
 			}
 
-			err := client.PATCH(fmt.Sprintf("/v3/funding-source/%s/permission-mapping", ID), patch)
+			err := client.PATCHContext(ctx, fmt.Sprintf("/v3/funding-source/%s/permission-mapping", ID), patch)
 			if err != nil {
 				return diag.FromErr(fmt.Errorf("unable to change permission mapping on Funding Source: %v", err))
 			}
@@ -244,7 +250,7 @@ func resourceFundingSourceUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	// Check for label changes and update accordingly
 	if d.HasChanges("labels") {
-		err := hc.PutAppLabelIDs(client, hc.FlattenAssociateLabels(d, "labels"), "funding-source", ID)
+		err := hc.PutAppLabelIDs(ctx, client, hc.FlattenAssociateLabels(d, "labels"), "funding-source", ID)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("unable to update funding source labels: %v", err))
 		}
@@ -264,12 +270,13 @@ func resourceFundingSourceDelete(ctx context.Context, d *schema.ResourceData, m
 	client := m.(*hc.Client)
 	ID := d.Id()
 
-	err := client.DELETE(fmt.Sprintf("/v3/funding-source/%s", ID), nil)
+	err := client.DELETEContext(ctx, fmt.Sprintf("/v3/funding-source/%s", ID), nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to delete Funding Source: %v", err))
 	}
+	diags := hc.DeleteUnusedResourceLabels(ctx, client, d)
 
 	d.SetId("")
 
-	return diag.Diagnostics{}
+	return diags
 }
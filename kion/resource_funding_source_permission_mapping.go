@@ -73,7 +73,7 @@ func resourceFundingSourcePermissionsMappingCreate(ctx context.Context, d *schem
 	}
 
 	// Make a PATCH request to the Kion API to create the permission mapping
-	err = client.PATCH(fmt.Sprintf("/v3/funding-source/%d/permission-mapping", fundingSourceID), []hc.FundingSourcePermissionsMapping{mapping})
+	err = client.PATCHContext(ctx, fmt.Sprintf("/v3/funding-source/%d/permission-mapping", fundingSourceID), []hc.FundingSourcePermissionsMapping{mapping})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -98,8 +98,11 @@ func resourceFundingSourcePermissionsMappingRead(ctx context.Context, d *schema.
 	fundingSourceID, appRoleID := ids[0], ids[1]
 
 	resp := new(hc.FundingSourcePermissionsMappingListResponse)
-	err = client.GET(fmt.Sprintf("/v3/funding-source/%d/permission-mapping", fundingSourceID), resp)
+	err = client.GETContext(ctx, fmt.Sprintf("/v3/funding-source/%d/permission-mapping", fundingSourceID), resp)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_funding_source_permission_mapping", err) {
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
@@ -118,7 +121,7 @@ func resourceFundingSourcePermissionsMappingRead(ctx context.Context, d *schema.
 	}
 
 	if !found {
-		d.SetId("")
+		hc.RemoveFromState(ctx, d, "kion_funding_source_permission_mapping")
 	}
 
 	return diags
@@ -143,7 +146,7 @@ func resourceFundingSourcePermissionsMappingUpdate(ctx context.Context, d *schem
 			UserIDs:       []int{},
 		}
 
-		err := client.PATCH(fmt.Sprintf("/v3/funding-source/%d/permission-mapping", fundingSourceID), []hc.FundingSourcePermissionsMapping{oldMapping})
+		err := client.PATCHContext(ctx, fmt.Sprintf("/v3/funding-source/%d/permission-mapping", fundingSourceID), []hc.FundingSourcePermissionsMapping{oldMapping})
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -169,7 +172,7 @@ func resourceFundingSourcePermissionsMappingUpdate(ctx context.Context, d *schem
 
 	// Fetch existing mappings from the API
 	resp := new(hc.FundingSourcePermissionsMappingListResponse)
-	err = client.GET(fmt.Sprintf("/v3/funding-source/%d/permission-mapping", fundingSourceID), resp)
+	err = client.GETContext(ctx, fmt.Sprintf("/v3/funding-source/%d/permission-mapping", fundingSourceID), resp)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -195,7 +198,7 @@ func resourceFundingSourcePermissionsMappingUpdate(ctx context.Context, d *schem
 	}
 
 	// Send the updated mappings to the Kion API
-	err = client.PATCH(fmt.Sprintf("/v3/funding-source/%d/permission-mapping", fundingSourceID), updatedMappings)
+	err = client.PATCHContext(ctx, fmt.Sprintf("/v3/funding-source/%d/permission-mapping", fundingSourceID), updatedMappings)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -224,7 +227,7 @@ func resourceFundingSourcePermissionsMappingDelete(ctx context.Context, d *schem
 	}
 
 	// Send the delete request to the Kion API
-	err = client.PATCH(fmt.Sprintf("/v3/funding-source/%d/permission-mapping", fundingSourceID), []hc.FundingSourcePermissionsMapping{mapping})
+	err = client.PATCHContext(ctx, fmt.Sprintf("/v3/funding-source/%d/permission-mapping", fundingSourceID), []hc.FundingSourcePermissionsMapping{mapping})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -86,9 +85,10 @@ func resourceGcpAccount() *schema.Resource {
 			"labels": {
 				Type:         schema.TypeMap,
 				Optional:     true,
+				Computed:     true,
 				RequiredWith: []string{"project_id"},
 				Elem:         &schema.Schema{Type: schema.TypeString},
-				Description:  "A map of labels to assign to the account. The labels must already exist in Kion.",
+				Description:  "A map of labels to assign to the account. The labels must already exist in Kion unless create_missing_labels is set on the provider.",
 			},
 			"last_updated": {
 				Type:     schema.TypeString,
@@ -154,6 +154,7 @@ func resourceGcpAccount() *schema.Resource {
 			// schema validators don't support multi-attribute validations, so we use CustomizeDiff instead
 			validateGcpAccountStartDatecode,
 			customDiffComputedAccountLocation,
+			hc.AccountDefaultLabelsDiff,
 		),
 	}
 }
@@ -200,7 +201,7 @@ func resourceGcpAccountCreate(ctx context.Context, d *schema.ResourceData, m int
 		if rb, err := json.Marshal(postAccountData); err == nil {
 			tflog.Debug(ctx, fmt.Sprintf("Importing exiting GCP Project via POST %s", accountURL), map[string]interface{}{"postData": string(rb)})
 		}
-		resp, err := client.POST(accountURL, postAccountData)
+		resp, err := client.POSTContext(ctx, accountURL, postAccountData)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -233,7 +234,7 @@ func resourceGcpAccountCreate(ctx context.Context, d *schema.ResourceData, m int
 		if rb, err := json.Marshal(postCacheData); err == nil {
 			tflog.Debug(ctx, "Creating new GCP account via POST /v3/account-cache/create?account-type=google-cloud", map[string]interface{}{"data": string(rb)})
 		}
-		respCache, err := client.POST("/v3/account-cache/create?account-type=google-cloud", postCacheData)
+		respCache, err := client.POSTContext(ctx, "/v3/account-cache/create?account-type=google-cloud", postCacheData)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -258,14 +259,12 @@ func resourceGcpAccountCreate(ctx context.Context, d *schema.ResourceData, m int
 		createStateConf := &retry.StateChangeConf{
 			Refresh: func() (interface{}, string, error) {
 				resp := new(hc.AccountResponse)
-				err := client.GET(fmt.Sprintf("/v3/account-cache/%d", accountCacheID), resp)
+				err := client.GETContext(ctx, fmt.Sprintf("/v3/account-cache/%d", accountCacheID), resp, hc.WithoutCache())
 				if err != nil {
-					if resErr, ok := err.(*hc.RequestError); ok {
-						if resErr.StatusCode == http.StatusNotFound {
-							// StateChangeConf handles 404s differently than errors, so return nil instead of err
-							tflog.Trace(ctx, fmt.Sprintf("Checking new GCP account status: /v3/account-cache/%d not found", accountCacheID))
-							return nil, "NotFound", nil
-						}
+					if errors.Is(err, hc.ErrNotFound) {
+						// StateChangeConf handles 404s differently than errors, so return nil instead of err
+						tflog.Trace(ctx, fmt.Sprintf("Checking new GCP account status: /v3/account-cache/%d not found", accountCacheID))
+						return nil, "NotFound", nil
 					}
 					tflog.Trace(ctx, fmt.Sprintf("Checking new GCP account status: /v3/account-cache/%d error", accountCacheID), map[string]interface{}{"error": err})
 					return nil, "Error", err
@@ -294,7 +293,7 @@ func resourceGcpAccountCreate(ctx context.Context, d *schema.ResourceData, m int
 			projectID := d.Get("project_id").(int)
 			startDatecode := time.Now().Format("200601")
 
-			newID, err := convertCacheAccountToProjectAccount(client, accountCacheID, projectID, startDatecode)
+			newID, err := convertCacheAccountToProjectAccount(ctx, client, accountCacheID, projectID, startDatecode)
 			if err != nil {
 				diags = append(diags, diag.Diagnostic{
 					Severity: diag.Error,
@@ -318,7 +317,7 @@ func resourceGcpAccountCreate(ctx context.Context, d *schema.ResourceData, m int
 	if accountLocation == ProjectLocation {
 		if _, ok := d.GetOk("labels"); ok {
 			ID := d.Id()
-			err := hc.PutAppLabelIDs(client, hc.FlattenAssociateLabels(d, "labels"), "account", ID)
+			err := hc.PutAppLabelIDs(ctx, client, hc.FlattenAssociateLabels(d, "labels"), "account", ID)
 
 			if err != nil {
 				diags = append(diags, diag.Diagnostic{
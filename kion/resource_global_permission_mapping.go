@@ -71,7 +71,7 @@ func resourceGlobalPermissionMappingCreate(ctx context.Context, d *schema.Resour
 	}
 
 	// Make a POST request to the Kion API to create the permission mapping
-	_, err = client.POST("/v3/global/permission-mapping", []hc.GlobalPermissionMapping{mapping})
+	_, err = client.POSTContext(ctx, "/v3/global/permission-mapping", []hc.GlobalPermissionMapping{mapping})
 	if err != nil {
 		return diag.FromErr(err) // Return an error diagnostic if the request fails
 	}
@@ -95,7 +95,7 @@ func resourceGlobalPermissionMappingRead(ctx context.Context, d *schema.Resource
 
 	// Make a GET request to the Kion API to fetch all global permission mappings
 	resp := new(hc.GlobalPermissionMappingListResponse)
-	err = client.GET("/v3/global/permission-mapping", resp)
+	err = client.GETContext(ctx, "/v3/global/permission-mapping", resp)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -120,7 +120,7 @@ func resourceGlobalPermissionMappingRead(ctx context.Context, d *schema.Resource
 
 	// If the mapping is not found, it implies the resource has been deleted externally
 	if !found {
-		d.SetId("") // Remove the ID to indicate the resource no longer exists
+		hc.RemoveFromState(ctx, d, "kion_global_permission_mapping")
 	}
 
 	return diags
@@ -144,7 +144,7 @@ func resourceGlobalPermissionMappingUpdate(ctx context.Context, d *schema.Resour
 			UserIDs:       []int{},
 		}
 
-		err := client.PATCH("/v3/global/permission-mapping", []hc.GlobalPermissionMapping{oldMapping})
+		err := client.PATCHContext(ctx, "/v3/global/permission-mapping", []hc.GlobalPermissionMapping{oldMapping})
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -170,7 +170,7 @@ func resourceGlobalPermissionMappingUpdate(ctx context.Context, d *schema.Resour
 
 	// Fetch existing mappings from the API
 	resp := new(hc.GlobalPermissionMappingListResponse)
-	err = client.GET("/v3/global/permission-mapping", resp)
+	err = client.GETContext(ctx, "/v3/global/permission-mapping", resp)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -196,7 +196,7 @@ func resourceGlobalPermissionMappingUpdate(ctx context.Context, d *schema.Resour
 	}
 
 	// Send the updated mappings to the Kion API
-	err = client.PATCH("/v3/global/permission-mapping", updatedMappings)
+	err = client.PATCHContext(ctx, "/v3/global/permission-mapping", updatedMappings)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -223,7 +223,7 @@ func resourceGlobalPermissionMappingDelete(ctx context.Context, d *schema.Resour
 	}
 
 	// Send the delete request to the Kion API
-	err = client.PATCH("/v3/global/permission-mapping", []hc.GlobalPermissionMapping{mapping})
+	err = client.PATCHContext(ctx, "/v3/global/permission-mapping", []hc.GlobalPermissionMapping{mapping})
 	if err != nil {
 		return diag.FromErr(err)
 	}
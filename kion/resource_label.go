@@ -58,7 +58,7 @@ func resourceLabelCreate(ctx context.Context, d *schema.ResourceData, m interfac
 		Value: d.Get("value").(string),
 	}
 
-	resp, err := client.POST("/v3/label", post)
+	resp, err := client.POSTContext(ctx, "/v3/label", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -88,8 +88,11 @@ func resourceLabelRead(ctx context.Context, d *schema.ResourceData, m interface{
 	ID := d.Id()
 
 	resp := new(hc.LabelResponse)
-	err := client.GET(fmt.Sprintf("/v3/label/%s", ID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/label/%s", ID), resp)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_label", err) {
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read Label",
@@ -144,7 +147,7 @@ func resourceLabelUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 			Value: d.Get("value").(string),
 		}
 
-		err := client.PATCH(fmt.Sprintf("/v3/label/%s", ID), req)
+		err := client.PATCHContext(ctx, fmt.Sprintf("/v3/label/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -167,7 +170,7 @@ func resourceLabelDelete(ctx context.Context, d *schema.ResourceData, m interfac
 	client := m.(*hc.Client)
 	ID := d.Id()
 
-	err := client.DELETE(fmt.Sprintf("/v3/label/%s", ID), nil)
+	err := client.DELETEContext(ctx, fmt.Sprintf("/v3/label/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
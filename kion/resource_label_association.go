@@ -0,0 +1,197 @@
+package kion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
+)
+
+func resourceLabelAssociation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Attaches a single label to an account, cloud rule, funding source, OU or project without touching its other labels. " +
+			"The object must not set its `labels` attribute, which replaces all of its labels. When the provider sets `default_labels`, " +
+			"the object manages its labels anyway, so add `lifecycle { ignore_changes = [labels] }` to it.",
+		CreateContext: resourceLabelAssociationCreate,
+		ReadContext:   resourceLabelAssociationRead,
+		DeleteContext: resourceLabelAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceLabelAssociationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The type of the object to label: " + strings.Join(hc.SupportedResourceTypes(), ", ") + ".",
+				ValidateFunc: validation.StringInSlice(hc.SupportedResourceTypes(), false),
+			},
+			"resource_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the object to label.",
+			},
+			"label_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"label_id", "key"},
+				Description:  "The ID of the label. Exactly one of label_id or key and value must be set.",
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"value"},
+				Description:  "The key of the label.",
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"key"},
+				Description:  "The value of the label.",
+			},
+		},
+	}
+}
+
+func resourceLabelAssociationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*hc.Client)
+	resourceType := d.Get("resource_type").(string)
+	resourceID := strconv.Itoa(d.Get("resource_id").(int))
+
+	var label *hc.Label
+	var err error
+	if labelID, ok := d.GetOk("label_id"); ok {
+		resp := new(hc.LabelResponse)
+		err = client.GETContext(ctx, fmt.Sprintf("/v3/label/%d", labelID.(int)), resp)
+		label = &resp.Data
+	} else {
+		label, err = hc.FindLabel(ctx, client, d.Get("key").(string), d.Get("value").(string))
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to find label",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), resourceType+"/"+resourceID),
+		})
+		return diags
+	}
+
+	associate := hc.AssociateLabel{ID: label.ID, Key: label.Key, Value: label.Value}
+	if err := hc.AddResourceLabel(ctx, client, resourceType, resourceID, associate); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to associate label",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), resourceType+"/"+resourceID),
+		})
+		return diags
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", resourceType, resourceID, label.ID))
+
+	return resourceLabelAssociationRead(ctx, d, m)
+}
+
+func resourceLabelAssociationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*hc.Client)
+	ID := d.Id()
+
+	resourceType, resourceID, labelID, err := parseLabelAssociationID(ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	label, err := hc.ReadResourceLabel(ctx, client, resourceType, resourceID, labelID)
+	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_label_association", err) {
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read label association",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+	if label == nil {
+		hc.RemoveFromState(ctx, d, "kion_label_association")
+		return diags
+	}
+
+	objectID, _ := strconv.Atoi(resourceID)
+	data := map[string]interface{}{
+		"resource_type": resourceType,
+		"resource_id":   objectID,
+		"label_id":      label.ID,
+		"key":           label.Key,
+		"value":         label.Value,
+	}
+	for k, v := range data {
+		diags = append(diags, hc.SafeSet(d, k, v, "Unable to set label association field")...)
+	}
+
+	return diags
+}
+
+func resourceLabelAssociationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*hc.Client)
+	ID := d.Id()
+
+	resourceType, resourceID, labelID, err := parseLabelAssociationID(ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The label is gone with the object if the object was deleted.
+	err = hc.RemoveResourceLabel(ctx, client, resourceType, resourceID, labelID)
+	if err != nil && !errors.Is(err, hc.ErrNotFound) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to remove label association",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceLabelAssociationImport accepts an ID of the form
+// resource_type/resource_id/label_id, e.g. project/12/34.
+func resourceLabelAssociationImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if _, _, _, err := parseLabelAssociationID(d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseLabelAssociationID splits an ID of the form
+// resource_type/resource_id/label_id.
+func parseLabelAssociationID(ID string) (string, string, int, error) {
+	parts := strings.Split(ID, "/")
+	if len(parts) == 3 && hc.IsSupportedResourceType(parts[0]) {
+		_, objectErr := strconv.Atoi(parts[1])
+		labelID, labelErr := strconv.Atoi(parts[2])
+		if objectErr == nil && labelErr == nil {
+			return parts[0], parts[1], labelID, nil
+		}
+	}
+	return "", "", 0, fmt.Errorf("invalid ID %q, expected resource_type/resource_id/label_id", ID)
+}
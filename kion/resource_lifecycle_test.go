@@ -0,0 +1,1292 @@
+package kion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
+	"github.com/kionsoftware/terraform-provider-kion/kion/internal/kionfake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lifecycleTest is a create, update, import and destroy run of a resource
+// against the fake Kion API.
+type lifecycleTest struct {
+	// collection is the fake collection the resource is stored in, if any.
+	collection string
+	// setup seeds the objects the resource depends on.
+	setup  func(server *kionfake.Server)
+	create map[string]interface{}
+	update map[string]interface{}
+	// importID is the ID to import the resource with, if it is not the ID
+	// in state.
+	importID string
+	// importIgnore are attributes Kion does not return, so an import
+	// cannot restore them.
+	importIgnore []string
+	// kept means Kion keeps the object on destroy, e.g. the app config,
+	// and the resource is only removed from state.
+	kept bool
+}
+
+func owners(ids ...int) []interface{} {
+	out := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, map[string]interface{}{"id": id})
+	}
+	return out
+}
+
+func TestResourceLifecycle(t *testing.T) {
+	cases := map[string]lifecycleTest{
+		"kion_app_config": {
+			create: map[string]interface{}{
+				"app_api_key_limit": 5,
+				"smtp_enabled":      true,
+				"smtp_host":         "smtp.example.com",
+				"smtp_port":         25,
+			},
+			update: map[string]interface{}{
+				"app_api_key_limit":     10,
+				"smtp_enabled":          true,
+				"smtp_host":             "mail.example.com",
+				"smtp_port":             587,
+				"supported_aws_regions": []interface{}{"us-east-1"},
+			},
+			kept: true,
+		},
+		"kion_aws_account": {
+			collection: "account",
+			create: map[string]interface{}{
+				"name":           "aws",
+				"account_number": "111111111111",
+				"email":          "root@example.com",
+				"payer_id":       1,
+				"project_id":     1,
+				"start_datecode": "2026-01",
+				"labels":         map[string]interface{}{"team": "platform"},
+			},
+			update: map[string]interface{}{
+				"name":           "renamed",
+				"account_alias":  "aws-alias",
+				"account_number": "111111111111",
+				"email":          "root@example.com",
+				"payer_id":       1,
+				"project_id":     1,
+				"start_datecode": "2026-01",
+				"labels":         map[string]interface{}{"team": "security"},
+			},
+		},
+		"kion_aws_cloudformation_template": {
+			collection: "cft",
+			create: map[string]interface{}{
+				"name":        "cft",
+				"policy":      `{"Resources":{}}`,
+				"regions":     []interface{}{"us-east-1"},
+				"tags":        map[string]interface{}{"team": "platform"},
+				"owner_users": owners(1),
+			},
+			update: map[string]interface{}{
+				"name":        "cft",
+				"description": "updated",
+				"policy":      `{"Resources":{}}`,
+				"regions":     []interface{}{"us-east-1", "us-west-2"},
+				"tags":        map[string]interface{}{"team": "security"},
+				"owner_users": owners(2),
+			},
+		},
+		"kion_aws_iam_policy": {
+			collection: "iam-policy",
+			create: map[string]interface{}{
+				"name":        "policy",
+				"policy":      `{"Version":"2012-10-17"}`,
+				"owner_users": owners(1, 2),
+			},
+			update: map[string]interface{}{
+				"name":              "renamed",
+				"policy":            `{"Version":"2012-10-17"}`,
+				"owner_users":       owners(2),
+				"owner_user_groups": owners(3),
+			},
+		},
+		"kion_azure_account": {
+			collection: "account",
+			create: map[string]interface{}{
+				"name":              "azure",
+				"subscription_uuid": "6e3a5c3e-0f6b-4b8a-9d3e-2f1c0a7b8d9e",
+				"payer_id":          1,
+				"project_id":        1,
+				"start_datecode":    "2026-01",
+				"labels":            map[string]interface{}{"team": "platform"},
+			},
+			update: map[string]interface{}{
+				"name":              "renamed",
+				"account_alias":     "azure-alias",
+				"subscription_uuid": "6e3a5c3e-0f6b-4b8a-9d3e-2f1c0a7b8d9e",
+				"payer_id":          1,
+				"project_id":        1,
+				"start_datecode":    "2026-01",
+				"labels":            map[string]interface{}{"team": "security"},
+			},
+		},
+		"kion_azure_arm_template": {
+			collection: "azure-arm-template",
+			create: map[string]interface{}{
+				"name":                     "arm",
+				"deployment_mode":          1,
+				"resource_group_name":      "rg",
+				"resource_group_region_id": 1,
+				"template":                 "{}",
+				"owner_users":              owners(1),
+			},
+			update: map[string]interface{}{
+				"name":                     "arm",
+				"description":              "updated",
+				"deployment_mode":          2,
+				"resource_group_name":      "rg",
+				"resource_group_region_id": 1,
+				"template":                 "{}",
+				"owner_user_groups":        owners(1),
+			},
+		},
+		"kion_azure_policy": {
+			collection: "azure-policy",
+			create: map[string]interface{}{
+				"name":        "policy",
+				"policy":      "{}",
+				"owner_users": owners(1),
+			},
+			update: map[string]interface{}{
+				"name":        "policy",
+				"description": "updated",
+				"parameters":  "{}",
+				"policy":      "{}",
+				"owner_users": owners(1, 2),
+			},
+		},
+		"kion_azure_role": {
+			collection: "azure-role",
+			create: map[string]interface{}{
+				"name":             "role",
+				"role_permissions": "{}",
+				"owner_users":      owners(1),
+			},
+			update: map[string]interface{}{
+				"name":              "role",
+				"description":       "updated",
+				"role_permissions":  `{"actions":["*"]}`,
+				"owner_user_groups": owners(1),
+			},
+		},
+		"kion_cloud_rule": {
+			collection: "cloud-rule",
+			create: map[string]interface{}{
+				"name":             "rule",
+				"aws_iam_policies": owners(1),
+				"ous":              owners(2),
+				"owner_users":      owners(1),
+			},
+			update: map[string]interface{}{
+				"name":              "rule",
+				"description":       "updated",
+				"aws_iam_policies":  owners(3),
+				"owner_user_groups": owners(1),
+			},
+		},
+		"kion_compliance_check": {
+			collection: "compliance/check",
+			create: map[string]interface{}{
+				"name":                     "check",
+				"cloud_provider_id":        1,
+				"compliance_check_type_id": 1,
+				"regions":                  []interface{}{"us-east-1"},
+				"owner_users":              owners(1),
+			},
+			update: map[string]interface{}{
+				"name":                     "check",
+				"description":              "updated",
+				"cloud_provider_id":        1,
+				"compliance_check_type_id": 1,
+				"regions":                  []interface{}{"us-east-1"},
+				"owner_users":              owners(2),
+			},
+		},
+		"kion_compliance_standard": {
+			collection: "compliance/standard",
+			create: map[string]interface{}{
+				"name":               "standard",
+				"created_by_user_id": 1,
+				"owner_users":        owners(1),
+			},
+			update: map[string]interface{}{
+				"name":               "standard",
+				"description":        "updated",
+				"created_by_user_id": 1,
+				"owner_users":        owners(1),
+				"owner_user_groups":  owners(2),
+			},
+		},
+		"kion_custom_account": {
+			collection: "account",
+			create: map[string]interface{}{
+				"name":           "custom",
+				"account_number": "custom-1",
+				"payer_id":       1,
+				"project_id":     1,
+				"start_datecode": "2026-01",
+				"labels":         map[string]interface{}{"team": "platform"},
+			},
+			update: map[string]interface{}{
+				"name":           "renamed",
+				"account_alias":  "custom-alias",
+				"account_number": "custom-1",
+				"payer_id":       1,
+				"project_id":     1,
+				"start_datecode": "2026-01",
+				"labels":         map[string]interface{}{"team": "security"},
+			},
+		},
+		"kion_custom_variable": {
+			collection: "custom-variable",
+			create: map[string]interface{}{
+				"name":                     "cost_center",
+				"description":              "cost center",
+				"type":                     "string",
+				"default_value_string":     "1000",
+				"key_validation_regex":     ".*",
+				"key_validation_message":   "any key",
+				"value_validation_regex":   ".*",
+				"value_validation_message": "any value",
+				"owner_user_ids":           []interface{}{1},
+			},
+			update: map[string]interface{}{
+				"name":                     "cost_center",
+				"description":              "updated",
+				"type":                     "string",
+				"default_value_string":     "2000",
+				"key_validation_regex":     ".*",
+				"key_validation_message":   "any key",
+				"value_validation_regex":   "^[0-9]+$",
+				"value_validation_message": "digits only",
+				"owner_user_ids":           []interface{}{1},
+				"owner_user_group_ids":     []interface{}{2},
+			},
+		},
+		"kion_custom_variable_override": {
+			setup: func(server *kionfake.Server) {
+				server.Seed("custom-variable", kionfake.Object{"name": "cost_center", "type": "string", "default_value": "1000"})
+				server.Seed("ou", kionfake.Object{"name": "ou"})
+			},
+			create: map[string]interface{}{
+				"custom_variable_id": "1",
+				"entity_type":        "ou",
+				"entity_id":          "2",
+				"value_string":       "2000",
+			},
+			update: map[string]interface{}{
+				"custom_variable_id": "1",
+				"entity_type":        "ou",
+				"entity_id":          "2",
+				"value_string":       "3000",
+			},
+		},
+		"kion_funding_source": {
+			collection: "funding-source",
+			create: map[string]interface{}{
+				"name":                 "fs",
+				"amount":               1000,
+				"start_datecode":       "2026-01",
+				"end_datecode":         "2026-12",
+				"ou_id":                1,
+				"permission_scheme_id": 1,
+			},
+			// Owners are the users and groups of the owner app role.
+			update: map[string]interface{}{
+				"name":                 "fs",
+				"description":          "updated",
+				"amount":               2000,
+				"start_datecode":       "2026-01",
+				"end_datecode":         "2026-12",
+				"ou_id":                1,
+				"permission_scheme_id": 1,
+				"owner_users":          owners(1),
+			},
+		},
+		"kion_global_permission_mapping": {
+			create: map[string]interface{}{
+				"app_role_id":     3,
+				"user_ids":        []interface{}{1},
+				"user_groups_ids": []interface{}{2},
+			},
+			update: map[string]interface{}{
+				"app_role_id":     3,
+				"user_ids":        []interface{}{1, 4},
+				"user_groups_ids": []interface{}{},
+			},
+		},
+		"kion_funding_source_permission_mapping": {
+			setup: func(server *kionfake.Server) {
+				server.Seed("funding-source", kionfake.Object{"name": "fs"})
+			},
+			create: map[string]interface{}{
+				"funding_source_id": 1,
+				"app_role_id":       3,
+				"user_ids":          []interface{}{1},
+				"user_groups_ids":   []interface{}{},
+			},
+			update: map[string]interface{}{
+				"funding_source_id": 1,
+				"app_role_id":       3,
+				"user_ids":          []interface{}{},
+				"user_groups_ids":   []interface{}{2},
+			},
+		},
+		"kion_gcp_account": {
+			collection: "account",
+			create: map[string]interface{}{
+				"name":                    "gcp",
+				"create_mode":             "import",
+				"google_cloud_project_id": "platform-1234",
+				"payer_id":                1,
+				"project_id":              1,
+				"start_datecode":          "2026-01",
+				"labels":                  map[string]interface{}{"team": "platform"},
+			},
+			update: map[string]interface{}{
+				"name":                    "renamed",
+				"account_alias":           "gcp-alias",
+				"create_mode":             "import",
+				"google_cloud_project_id": "platform-1234",
+				"payer_id":                1,
+				"project_id":              1,
+				"start_datecode":          "2026-01",
+				"labels":                  map[string]interface{}{"team": "security"},
+			},
+			// create_mode is only sent when the account is created.
+			importIgnore: []string{"create_mode"},
+		},
+		"kion_gcp_iam_role": {
+			collection: "gcp-iam-role",
+			create: map[string]interface{}{
+				"name":                  "role",
+				"gcp_role_launch_stage": 1,
+				"role_permissions":      []interface{}{"compute.instances.get"},
+				"owner_users":           owners(1),
+			},
+			update: map[string]interface{}{
+				"name":                  "role",
+				"description":           "updated",
+				"gcp_role_launch_stage": 2,
+				"role_permissions":      []interface{}{"compute.instances.get", "compute.instances.list"},
+				"owner_user_groups":     owners(1),
+			},
+		},
+		"kion_label": {
+			collection: "label",
+			create: map[string]interface{}{
+				"key":   "team",
+				"value": "platform",
+				"color": "#123abc",
+			},
+			update: map[string]interface{}{
+				"key":   "team",
+				"value": "security",
+				"color": "#abc123",
+			},
+		},
+		"kion_ou": {
+			collection: "ou",
+			create: map[string]interface{}{
+				"name":                 "ou",
+				"parent_ou_id":         0,
+				"permission_scheme_id": 1,
+				"labels":               map[string]interface{}{"team": "platform"},
+				"owner_users":          owners(1),
+			},
+			update: map[string]interface{}{
+				"name":                 "renamed",
+				"description":          "updated",
+				"parent_ou_id":         0,
+				"permission_scheme_id": 1,
+				"labels":               map[string]interface{}{"team": "security"},
+				"owner_users":          owners(1),
+				"owner_user_groups":    owners(2),
+			},
+		},
+		"kion_ou_cloud_access_role": {
+			collection: "ou-cloud-access-role",
+			create: map[string]interface{}{
+				"name":              "admin",
+				"ou_id":             1,
+				"aws_iam_role_name": "admin",
+				"aws_iam_policies":  owners(1),
+				"users":             owners(1),
+				"web_access":        true,
+			},
+			update: map[string]interface{}{
+				"name":                   "admin",
+				"ou_id":                  1,
+				"aws_iam_role_name":      "admin",
+				"aws_iam_policies":       owners(2),
+				"user_groups":            owners(1),
+				"short_term_access_keys": true,
+				"web_access":             true,
+			},
+		},
+		"kion_ou_permission_mapping": {
+			setup: func(server *kionfake.Server) {
+				server.Seed("ou", kionfake.Object{"name": "parent"})
+			},
+			create: map[string]interface{}{
+				"ou_id":           1,
+				"app_role_id":     3,
+				"user_ids":        []interface{}{1},
+				"user_groups_ids": []interface{}{},
+			},
+			update: map[string]interface{}{
+				"ou_id":           1,
+				"app_role_id":     3,
+				"user_ids":        []interface{}{2},
+				"user_groups_ids": []interface{}{5},
+			},
+		},
+		"kion_permission_scheme": {
+			collection: "permission-scheme",
+			create: map[string]interface{}{
+				"name":        "ou-operators",
+				"scheme_type": "ou",
+				"grant": []interface{}{
+					map[string]interface{}{"app_role_id": 1, "permission_ids": []interface{}{1, 2}},
+				},
+			},
+			update: map[string]interface{}{
+				"name":        "ou-operators",
+				"description": "updated",
+				"scheme_type": "ou",
+				"grant": []interface{}{
+					map[string]interface{}{"app_role_id": 1, "permission_ids": []interface{}{1, 2, 3}},
+					map[string]interface{}{"app_role_id": 2, "permission_ids": []interface{}{1}},
+				},
+			},
+		},
+		"kion_project": {
+			collection: "project",
+			setup:      handleProjectCreate,
+			create: map[string]interface{}{
+				"name":                 "project",
+				"ou_id":                1,
+				"permission_scheme_id": 1,
+				"owner_user_ids":       owners(1),
+				"project_funding": []interface{}{map[string]interface{}{
+					"amount":            1000,
+					"funding_order":     1,
+					"funding_source_id": 1,
+					"start_datecode":    "2026-01",
+					"end_datecode":      "2026-12",
+				}},
+				"labels": map[string]interface{}{"team": "platform"},
+			},
+			update: map[string]interface{}{
+				"name":                 "renamed",
+				"description":          "updated",
+				"ou_id":                1,
+				"permission_scheme_id": 1,
+				"owner_user_ids":       owners(1, 2),
+				"project_funding": []interface{}{map[string]interface{}{
+					"amount":            1000,
+					"funding_order":     1,
+					"funding_source_id": 1,
+					"start_datecode":    "2026-01",
+					"end_datecode":      "2026-12",
+				}},
+				"labels": map[string]interface{}{"team": "security"},
+			},
+			// Kion returns neither the owners, the permission scheme nor the
+			// funding of a project.
+			importIgnore: []string{"owner_user_ids", "permission_scheme_id", "project_funding"},
+		},
+		"kion_project_enforcement": {
+			collection: "enforcement",
+			setup: func(server *kionfake.Server) {
+				handleProjectEnforcements(server)
+				server.Seed("project", kionfake.Object{"name": "project"})
+			},
+			create: map[string]interface{}{
+				"project_id":     1,
+				"description":    "limit",
+				"timeframe":      "month",
+				"spend_option":   "spend",
+				"amount_type":    "custom",
+				"threshold_type": "dollar",
+				"threshold":      100,
+				"user_ids":       []interface{}{1},
+			},
+			update: map[string]interface{}{
+				"project_id":     1,
+				"description":    "updated",
+				"timeframe":      "month",
+				"spend_option":   "spend",
+				"amount_type":    "custom",
+				"threshold_type": "dollar",
+				"threshold":      200,
+				"user_ids":       []interface{}{2},
+				"user_group_ids": []interface{}{3},
+			},
+			importID: "1/2",
+		},
+		"kion_project_cloud_access_role": {
+			collection: "project-cloud-access-role",
+			create: map[string]interface{}{
+				"name":              "admin",
+				"project_id":        1,
+				"aws_iam_role_name": "admin",
+				"accounts":          owners(1),
+				"aws_iam_policies":  owners(1),
+				"users":             owners(1),
+				"web_access":        true,
+			},
+			update: map[string]interface{}{
+				"name":              "admin",
+				"project_id":        1,
+				"aws_iam_role_name": "admin",
+				"future_accounts":   true,
+				"aws_iam_policies":  owners(2),
+				"user_groups":       owners(1),
+				"web_access":        true,
+			},
+		},
+		"kion_project_note": {
+			collection: "project-note",
+			create: map[string]interface{}{
+				"name":           "runbook",
+				"text":           "Call the on-call engineer.",
+				"project_id":     1,
+				"create_user_id": 1,
+			},
+			update: map[string]interface{}{
+				"name":           "runbook",
+				"text":           "Page the on-call engineer.",
+				"project_id":     1,
+				"create_user_id": 1,
+			},
+		},
+		"kion_project_permission_mapping": {
+			setup: func(server *kionfake.Server) {
+				server.Seed("project", kionfake.Object{"name": "project"})
+			},
+			create: map[string]interface{}{
+				"project_id":      1,
+				"app_role_id":     3,
+				"user_ids":        []interface{}{1},
+				"user_groups_ids": []interface{}{},
+			},
+			update: map[string]interface{}{
+				"project_id":      1,
+				"app_role_id":     3,
+				"user_ids":        []interface{}{1, 2},
+				"user_groups_ids": []interface{}{},
+			},
+		},
+		"kion_saml_group_association": {
+			collection: "idms/group-association",
+			create: map[string]interface{}{
+				"assertion_name":  "memberOf",
+				"assertion_regex": "^admins$",
+				"idms_id":         2,
+				"user_group_id":   1,
+			},
+			update: map[string]interface{}{
+				"assertion_name":  "memberOf",
+				"assertion_regex": "^operators$",
+				"idms_id":         2,
+				"update_on_login": true,
+				"user_group_id":   1,
+			},
+		},
+		"kion_service_control_policy": {
+			collection: "service-control-policy",
+			create: map[string]interface{}{
+				"name":        "scp",
+				"policy":      "{}",
+				"owner_users": owners(1),
+			},
+			update: map[string]interface{}{
+				"name":        "scp",
+				"description": "updated",
+				"policy":      `{"Version":"2012-10-17"}`,
+				"owner_users": owners(2),
+			},
+		},
+		"kion_webhook": {
+			collection: "webhook",
+			create: map[string]interface{}{
+				"name":               "hook",
+				"callout_url":        "https://example.com/hook",
+				"request_method":     "POST",
+				"timeout_in_seconds": 10,
+				"owner_user_ids":     []interface{}{1},
+			},
+			update: map[string]interface{}{
+				"name":                 "hook",
+				"description":          "updated",
+				"callout_url":          "https://example.com/other",
+				"request_method":       "PUT",
+				"request_body":         "{}",
+				"timeout_in_seconds":   30,
+				"owner_user_ids":       []interface{}{2},
+				"owner_user_group_ids": []interface{}{1},
+			},
+		},
+		"kion_user_group": {
+			collection: "user-group",
+			create: map[string]interface{}{
+				"name":        "group",
+				"idms_id":     1,
+				"owner_users": owners(1),
+				"users":       owners(1, 2),
+			},
+			update: map[string]interface{}{
+				"name":         "group",
+				"description":  "updated",
+				"idms_id":      1,
+				"owner_users":  owners(1),
+				"owner_groups": owners(3),
+				"users":        owners(2, 3),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			testResourceLifecycle(t, name, tc)
+		})
+	}
+}
+
+func TestResourceDefaultLabels(t *testing.T) {
+	ctx := context.Background()
+	server, client := newFakeClient(t)
+	client.DefaultLabels = map[string]string{"owner": "platform", "cost-center": "42"}
+	res := Provider().ResourcesMap["kion_ou"]
+
+	config := map[string]interface{}{
+		"name":                 "ou",
+		"parent_ou_id":         0,
+		"permission_scheme_id": 1,
+		"labels":               map[string]interface{}{"owner": "team"},
+		"owner_users":          owners(1),
+	}
+	state := testApply(ctx, t, res, nil, config, client)
+	assert.Equal(t, map[string]interface{}{"owner": "team", "cost-center": "42"}, res.Data(state).Get("labels"))
+	id, err := strconv.Atoi(state.ID)
+	require.NoError(t, err)
+	assert.Len(t, server.Object("ou", id)["labels"], 2)
+	testCheckNoDrift(ctx, t, res, state, config, client)
+
+	// Without resource labels the defaults remain.
+	delete(config, "labels")
+	state = testApply(ctx, t, res, state, config, client)
+	assert.Equal(t, map[string]interface{}{"owner": "platform", "cost-center": "42"}, res.Data(state).Get("labels"))
+	testCheckNoDrift(ctx, t, res, state, config, client)
+
+	// Accounts in the account cache have no labels.
+	res = Provider().ResourcesMap["kion_aws_account"]
+	config = map[string]interface{}{
+		"name":           "account",
+		"account_number": "111111111111",
+		"payer_id":       1,
+	}
+	diff, err := res.Diff(ctx, testPriorState(t, res, nil, config), terraform.NewResourceConfigRaw(config), client)
+	require.NoError(t, err)
+	assert.NotContains(t, diff.Attributes, "labels.owner")
+}
+
+func TestResourceAppAPIKey(t *testing.T) {
+	ctx := context.Background()
+	server, client := newFakeClient(t)
+	res := Provider().ResourcesMap["kion_app_api_key"]
+
+	// Kion returns the secret of a key only when it is created.
+	server.Handle("POST /api/v3/app-api-key", func(w http.ResponseWriter, r *http.Request) {
+		var req hc.AppAPIKeyCreate
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		id := server.Seed("app-api-key", kionfake.Object{
+			"name":       req.Name,
+			"user_id":    req.UserID,
+			"created_at": time.Now().UTC().Format(time.RFC3339),
+		})
+		_, _ = fmt.Fprintf(w, `{"data":{"id":%d,"key":"app_%d_secret"},"status":201}`, id, id)
+	})
+
+	config := map[string]interface{}{
+		"name":          "ci",
+		"user_id":       5,
+		"rotation_days": 30,
+	}
+	state := testApply(ctx, t, res, nil, config, client)
+	first := state.ID
+	assert.Equal(t, "app_"+first+"_secret", state.Attributes["key"])
+	testCheckState(t, res, state, config)
+	testCheckNoDrift(ctx, t, res, state, config, client)
+
+	// Changing rotate_when_changed creates a new key and revokes the old one.
+	config["rotate_when_changed"] = map[string]interface{}{"version": "2"}
+	state = testApply(ctx, t, res, state, config, client)
+	second := state.ID
+	assert.NotEqual(t, first, second)
+	assert.Equal(t, "app_"+second+"_secret", state.Attributes["key"])
+	assert.Equal(t, 1, server.Len("app-api-key"))
+	testCheckNoDrift(ctx, t, res, state, config, client)
+
+	// A key older than rotation_days is rotated on the next apply.
+	old := time.Now().AddDate(0, 0, -31).UTC().Format(time.RFC3339)
+	require.NoError(t, client.PATCH("/v3/app-api-key/"+second, map[string]interface{}{"created_at": old}))
+	state, diags := res.RefreshWithoutUpgrade(ctx, state, client)
+	require.False(t, diags.HasError(), "refresh: %v", diags)
+	state = testApply(ctx, t, res, state, config, client)
+	assert.NotEqual(t, second, state.ID)
+	assert.Equal(t, 1, server.Len("app-api-key"))
+	testCheckNoDrift(ctx, t, res, state, config, client)
+
+	imported := testImport(ctx, t, res, state.ID, client)
+	assert.Equal(t, "ci", imported.Attributes["name"])
+	assert.Empty(t, imported.Attributes["key"])
+
+	_, diags = res.Apply(ctx, state, &terraform.InstanceDiff{Destroy: true}, client)
+	require.False(t, diags.HasError(), "destroy: %v", diags)
+	assert.Zero(t, server.Len("app-api-key"))
+}
+
+func TestResourceCreateMissingLabels(t *testing.T) {
+	ctx := context.Background()
+	server, client := newFakeClient(t)
+	client.CreateMissingLabels = true
+	client.MissingLabelColor = "#9e9e9e"
+	client.DeleteUnusedLabels = true
+	res := Provider().ResourcesMap["kion_ou"]
+
+	server.Seed("label", kionfake.Object{"key": "team", "value": "a", "color": "#ff0000"})
+	server.Seed("project", kionfake.Object{
+		"name":   "project",
+		"labels": []interface{}{map[string]interface{}{"key": "env", "value": "prod"}},
+	})
+
+	config := map[string]interface{}{
+		"name":                 "ou",
+		"parent_ou_id":         0,
+		"permission_scheme_id": 1,
+		"labels":               map[string]interface{}{"team": "a", "env": "prod", "tier": "gold"},
+		"owner_users":          owners(1),
+	}
+	state := testApply(ctx, t, res, nil, config, client)
+	assert.Equal(t, map[string]string{"team=a": "#ff0000", "env=prod": "#9e9e9e", "tier=gold": "#9e9e9e"}, labelColors(server))
+
+	// tier=gold is no longer used; env=prod is still used by the project.
+	config["labels"] = map[string]interface{}{"team": "a"}
+	state = testApply(ctx, t, res, state, config, client)
+	assert.Equal(t, map[string]string{"team=a": "#ff0000", "env=prod": "#9e9e9e"}, labelColors(server))
+
+	// Labels that were not created by the provider are never deleted.
+	_, diags := res.Apply(ctx, state, &terraform.InstanceDiff{Destroy: true}, client)
+	require.False(t, diags.HasError(), "destroy: %v", diags)
+	assert.Empty(t, diags)
+	assert.Equal(t, map[string]string{"team=a": "#ff0000", "env=prod": "#9e9e9e"}, labelColors(server))
+}
+
+func TestResourceLabelAssociation(t *testing.T) {
+	ctx := context.Background()
+	server, client := newFakeClient(t)
+	res := Provider().ResourcesMap["kion_label_association"]
+
+	team := server.Seed("label", kionfake.Object{"key": "team", "value": "a", "color": "#ff0000"})
+	env := server.Seed("label", kionfake.Object{"key": "env", "value": "prod", "color": "#00ff00"})
+	tier := server.Seed("label", kionfake.Object{"key": "tier", "value": "gold", "color": "#0000ff"})
+	project := server.Seed("project", kionfake.Object{
+		"name":   "project",
+		"labels": []interface{}{map[string]interface{}{"id": team, "key": "team", "value": "a"}},
+	})
+	projectLabels := func() map[string]string {
+		labels := make(map[string]string)
+		for _, label := range server.Object("project", project)["labels"].([]interface{}) {
+			label := label.(map[string]interface{})
+			labels[fmt.Sprint(label["key"])] = fmt.Sprint(label["value"])
+		}
+		return labels
+	}
+
+	config := map[string]interface{}{
+		"resource_type": "project",
+		"resource_id":   project,
+		"label_id":      env,
+	}
+	state := testApply(ctx, t, res, nil, config, client)
+	assert.Equal(t, fmt.Sprintf("project/%d/%d", project, env), state.ID)
+	assert.Equal(t, map[string]string{"team": "a", "env": "prod"}, projectLabels())
+	testCheckState(t, res, state, config)
+	testCheckNoDrift(ctx, t, res, state, config, client)
+
+	// Changing the label replaces the association.
+	config = map[string]interface{}{
+		"resource_type": "project",
+		"resource_id":   project,
+		"key":           "tier",
+		"value":         "gold",
+	}
+	state = testApply(ctx, t, res, state, config, client)
+	assert.Equal(t, fmt.Sprintf("project/%d/%d", project, tier), state.ID)
+	assert.Equal(t, map[string]string{"team": "a", "tier": "gold"}, projectLabels())
+	testCheckNoDrift(ctx, t, res, state, config, client)
+
+	imported := testImport(ctx, t, res, state.ID, client)
+	testCheckState(t, res, imported, config)
+	_, err := res.Importer.StateContext(ctx, res.Data(&terraform.InstanceState{ID: "project/1"}), client)
+	assert.ErrorContains(t, err, "expected resource_type/resource_id/label_id")
+
+	// Only the associated label is removed.
+	_, diags := res.Apply(ctx, state, &terraform.InstanceDiff{Destroy: true}, client)
+	require.False(t, diags.HasError(), "destroy: %v", diags)
+	assert.Equal(t, map[string]string{"team": "a"}, projectLabels())
+
+	refreshed, diags := res.RefreshWithoutUpgrade(ctx, state, client)
+	assert.False(t, diags.HasError(), "refresh: %v", diags)
+	assert.Nil(t, refreshed)
+
+	// A missing label is an error unless create_missing_labels is set.
+	config["value"] = "silver"
+	diff, err := res.Diff(ctx, testPriorState(t, res, nil, config), terraform.NewResourceConfigRaw(config), client)
+	require.NoError(t, err)
+	_, diags = res.Apply(ctx, nil, diff, client)
+	assert.True(t, diags.HasError())
+}
+
+// TestResourceLabelAssociationOwner checks that an object without a labels
+// attribute keeps the labels attached with kion_label_association.
+func TestResourceLabelAssociationOwner(t *testing.T) {
+	ctx := context.Background()
+	server, client := newFakeClient(t)
+	handleProjectCreate(server)
+	projectRes := Provider().ResourcesMap["kion_project"]
+	res := Provider().ResourcesMap["kion_label_association"]
+
+	env := server.Seed("label", kionfake.Object{"key": "env", "value": "prod", "color": "#00ff00"})
+	projectConfig := map[string]interface{}{
+		"name":                 "project",
+		"ou_id":                1,
+		"permission_scheme_id": 1,
+		"owner_user_ids":       owners(1),
+	}
+	projectState := testApply(ctx, t, projectRes, nil, projectConfig, client)
+	project, err := strconv.Atoi(projectState.ID)
+	require.NoError(t, err)
+
+	config := map[string]interface{}{
+		"resource_type": "project",
+		"resource_id":   project,
+		"label_id":      env,
+	}
+	state := testApply(ctx, t, res, nil, config, client)
+
+	projectState, diags := projectRes.RefreshWithoutUpgrade(ctx, projectState, client)
+	require.False(t, diags.HasError(), "refresh: %v", diags)
+	testCheckNoDrift(ctx, t, projectRes, projectState, projectConfig, client)
+
+	projectConfig["description"] = "updated"
+	projectState = testApply(ctx, t, projectRes, projectState, projectConfig, client)
+	assert.Equal(t, map[string]interface{}{"env": "prod"}, projectRes.Data(projectState).Get("labels"))
+	assert.Len(t, server.Object("project", project)["labels"], 1)
+	testCheckNoDrift(ctx, t, res, state, config, client)
+}
+
+func TestResourcePermissionSchemeGrants(t *testing.T) {
+	ctx := context.Background()
+	_, client := newFakeClient(t)
+	res := Provider().ResourcesMap["kion_permission_scheme"]
+
+	config := map[string]interface{}{
+		"name":        "ou-operators",
+		"scheme_type": "ou",
+		"grant": []interface{}{
+			map[string]interface{}{"app_role_id": 1, "permission_ids": []interface{}{1}},
+			map[string]interface{}{"app_role_id": 1, "permission_ids": []interface{}{2}},
+		},
+	}
+	_, err := res.Diff(ctx, testPriorState(t, res, nil, config), terraform.NewResourceConfigRaw(config), client)
+	assert.ErrorContains(t, err, "app role 1 is granted more than once")
+
+	// App roles that are unknown until apply, e.g. from another resource,
+	// are not duplicates.
+	prior := testPriorState(t, res, nil, config)
+	prior.RawConfig, err = cty.Transform(prior.RawConfig, func(path cty.Path, v cty.Value) (cty.Value, error) {
+		if len(path) == 0 {
+			return v, nil
+		}
+		if step, ok := path[len(path)-1].(cty.GetAttrStep); ok && step.Name == "app_role_id" {
+			return cty.UnknownVal(cty.Number), nil
+		}
+		return v, nil
+	})
+	require.NoError(t, err)
+	for _, grant := range config["grant"].([]interface{}) {
+		// The value the SDK uses for unknown values in a raw configuration.
+		grant.(map[string]interface{})["app_role_id"] = "74D93920-ED26-11E3-AC10-0800200C9A66"
+	}
+	_, err = res.Diff(ctx, prior, terraform.NewResourceConfigRaw(config), client)
+	assert.NoError(t, err)
+}
+
+func TestResourceUser(t *testing.T) {
+	ctx := context.Background()
+	server, client := newFakeClient(t)
+	res := Provider().ResourcesMap["kion_user"]
+
+	var passwords []hc.UserPassword
+	server.Handle("PUT /api/v3/user/{id}/password", func(w http.ResponseWriter, r *http.Request) {
+		var req hc.UserPassword
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		passwords = append(passwords, req)
+		_, _ = w.Write([]byte(`{"status":200}`))
+	})
+
+	config := map[string]interface{}{
+		"username":   "break-glass",
+		"first_name": "Break",
+		"last_name":  "Glass",
+		"email":      "break-glass@example.com",
+		"password":   "initial",
+	}
+	state := testApply(ctx, t, res, nil, config, client)
+	id, err := strconv.Atoi(state.ID)
+	require.NoError(t, err)
+	user := server.Object("user", id)
+	assert.Equal(t, "initial", user["password"])
+	assert.Equal(t, true, user["require_password_reset"])
+	assert.Equal(t, true, user["enabled"])
+	assert.EqualValues(t, 1, user["idms_id"])
+	testCheckState(t, res, state, config)
+	testCheckNoDrift(ctx, t, res, state, config, client)
+
+	config["phone"] = "555-0100"
+	config["enabled"] = false
+	config["password"] = "rotated"
+	config["require_password_reset"] = false
+	state = testApply(ctx, t, res, state, config, client)
+	user = server.Object("user", id)
+	assert.Equal(t, "555-0100", user["phone"])
+	assert.Equal(t, false, user["enabled"])
+	assert.Equal(t, []hc.UserPassword{{Password: "rotated", RequirePasswordReset: false}}, passwords)
+	testCheckNoDrift(ctx, t, res, state, config, client)
+
+	// Users are imported by ID or by username; the password is not returned.
+	delete(config, "password")
+	delete(config, "require_password_reset")
+	for _, importID := range []string{state.ID, "break-glass"} {
+		imported := testImport(ctx, t, res, importID, client)
+		assert.Equal(t, state.ID, imported.ID)
+		testCheckState(t, res, imported, config)
+		testCheckNoDrift(ctx, t, res, imported, config, client)
+	}
+	_, err = res.Importer.StateContext(ctx, res.Data(&terraform.InstanceState{ID: "nobody"}), client)
+	assert.ErrorContains(t, err, "nobody")
+
+	// disable_on_destroy keeps the user.
+	config["disable_on_destroy"] = true
+	config["enabled"] = true
+	state = testApply(ctx, t, res, state, config, client)
+	assert.Equal(t, true, server.Object("user", id)["enabled"])
+	_, diags := res.Apply(ctx, state, &terraform.InstanceDiff{Destroy: true}, client)
+	require.False(t, diags.HasError(), "destroy: %v", diags)
+	assert.Equal(t, false, server.Object("user", id)["enabled"])
+
+	config["disable_on_destroy"] = false
+	state = testApply(ctx, t, res, state, config, client)
+	_, diags = res.Apply(ctx, state, &terraform.InstanceDiff{Destroy: true}, client)
+	require.False(t, diags.HasError(), "destroy: %v", diags)
+	assert.Nil(t, server.Object("user", id))
+}
+
+func TestResourceWriteOnlySecrets(t *testing.T) {
+	ctx := context.Background()
+	server, client := newFakeClient(t)
+
+	// Write-only headers are sent on create and when their version changes,
+	// but never read back into request_headers.
+	res := Provider().ResourcesMap["kion_webhook"]
+	config := map[string]interface{}{
+		"name":                       "hook",
+		"callout_url":                "https://example.com/hook",
+		"request_method":             "POST",
+		"timeout_in_seconds":         10,
+		"owner_user_ids":             []interface{}{1},
+		"request_headers_wo":         `{"Authorization": "Bearer one"}`,
+		"request_headers_wo_version": 1,
+	}
+	state := testApply(ctx, t, res, nil, config, client)
+	id, err := strconv.Atoi(state.ID)
+	require.NoError(t, err)
+	assert.Equal(t, `{"Authorization":"Bearer one"}`, server.Object("webhook", id)["request_headers"])
+	assert.Empty(t, state.Attributes["request_headers"])
+
+	config["request_headers_wo"] = `{"Authorization": "Bearer two"}`
+	config["description"] = "unrelated change"
+	state = testApply(ctx, t, res, state, config, client)
+	assert.Equal(t, `{"Authorization":"Bearer one"}`, server.Object("webhook", id)["request_headers"])
+
+	config["request_headers_wo_version"] = 2
+	state = testApply(ctx, t, res, state, config, client)
+	assert.Equal(t, `{"Authorization":"Bearer two"}`, server.Object("webhook", id)["request_headers"])
+	assert.Empty(t, state.Attributes["request_headers"])
+
+	// The same goes for the password of a user.
+	var passwords []string
+	server.Handle("PUT /api/v3/user/{id}/password", func(w http.ResponseWriter, r *http.Request) {
+		var req hc.UserPassword
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		passwords = append(passwords, req.Password)
+		_, _ = w.Write([]byte(`{"status":200}`))
+	})
+	res = Provider().ResourcesMap["kion_user"]
+	config = map[string]interface{}{
+		"username":            "service",
+		"first_name":          "Service",
+		"last_name":           "Account",
+		"password_wo":         "one",
+		"password_wo_version": 1,
+	}
+	state = testApply(ctx, t, res, nil, config, client)
+	id, err = strconv.Atoi(state.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "one", server.Object("user", id)["password"])
+
+	config["password_wo"] = "two"
+	config["phone"] = "555-0100"
+	state = testApply(ctx, t, res, state, config, client)
+	assert.Empty(t, passwords)
+
+	config["password_wo_version"] = 2
+	testApply(ctx, t, res, state, config, client)
+	assert.Equal(t, []string{"two"}, passwords)
+
+	// Write-only and plain attributes cannot be mixed, and a write-only
+	// value needs a version.
+	config["password"] = "plain"
+	diags := res.Validate(terraform.NewResourceConfigRaw(config))
+	assert.True(t, diags.HasError())
+	delete(config, "password")
+	delete(config, "password_wo_version")
+	diags = res.Validate(terraform.NewResourceConfigRaw(config))
+	assert.True(t, diags.HasError())
+}
+
+func TestResourceProjectEnforcementImport(t *testing.T) {
+	ctx := context.Background()
+	server, client := newFakeClient(t)
+	handleProjectEnforcements(server)
+	server.Seed("project", kionfake.Object{"name": "project"})
+	server.Seed("project", kionfake.Object{"name": "other"})
+	id := server.Seed("enforcement", kionfake.Object{"description": "limit", "timeframe": "month", "threshold": 100})
+
+	// An enforcement imported by its ID alone is found by searching the
+	// projects, so imports written before project_id/enforcement_id keep
+	// working.
+	res := Provider().ResourcesMap["kion_project_enforcement"]
+	for _, importID := range []string{"1/" + strconv.Itoa(id), strconv.Itoa(id)} {
+		imported := testImport(ctx, t, res, importID, client)
+		assert.Equal(t, strconv.Itoa(id), imported.ID)
+		assert.Equal(t, "1", imported.Attributes["project_id"])
+		assert.Equal(t, "limit", imported.Attributes["description"])
+	}
+
+	_, err := res.Importer.StateContext(ctx, res.Data(&terraform.InstanceState{ID: "99"}), client)
+	assert.ErrorContains(t, err, "99 not found")
+	_, err = res.Importer.StateContext(ctx, res.Data(&terraform.InstanceState{ID: "/1"}), client)
+	assert.ErrorContains(t, err, "expected project_id/enforcement_id")
+}
+
+// labelColors returns the color of every label of the fake by key=value.
+func labelColors(server *kionfake.Server) map[string]string {
+	colors := make(map[string]string)
+	for _, label := range server.Objects("label") {
+		colors[fmt.Sprintf("%v=%v", label["key"], label["value"])] = fmt.Sprint(label["color"])
+	}
+	return colors
+}
+
+// handleProjectCreate serves project creation, which Kion does through
+// POST /v3/project/with-spend-plan with the funding of the project.
+func handleProjectCreate(server *kionfake.Server) {
+	server.Handle("POST /api/v3/project/with-spend-plan", func(w http.ResponseWriter, r *http.Request) {
+		var project kionfake.Object
+		if err := json.NewDecoder(r.Body).Decode(&project); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		id := server.Seed("project", project)
+		_, _ = fmt.Fprintf(w, `{"record_id":%d,"status":201}`, id)
+	})
+}
+
+// handleProjectEnforcements serves the enforcements below a project from an
+// "enforcement" collection, e.g. PATCH /v3/project/1/enforcement/2 updates
+// enforcement 2.
+func handleProjectEnforcements(server *kionfake.Server) {
+	server.AddCollection(kionfake.Collection{Path: "enforcement"})
+	forward := func(w http.ResponseWriter, r *http.Request) {
+		r.URL.Path = "/api/v3/enforcement/" + r.PathValue("rest")
+		server.Config.Handler.ServeHTTP(w, r)
+	}
+	server.Handle("/api/v3/project/{project}/enforcement", forward)
+	server.Handle("/api/v3/project/{project}/enforcement/{rest...}", forward)
+}
+
+// newFakeClient starts a fake Kion API and returns a client for it.
+func newFakeClient(t *testing.T) (*kionfake.Server, *hc.Client) {
+	server := kionfake.NewServer()
+	t.Cleanup(server.Close)
+
+	client, err := hc.NewClient(server.URL, "app_1_test", "/api", false)
+	require.NoError(t, err)
+	client.MaxRetries = 0
+	return server, client
+}
+
+// testResourceLifecycle creates, updates, imports and destroys the resource,
+// checking after every step that Kion holds the configuration and that a
+// new plan would be empty.
+func testResourceLifecycle(t *testing.T, name string, tc lifecycleTest) {
+	ctx := context.Background()
+	server, client := newFakeClient(t)
+	res := Provider().ResourcesMap[name]
+	require.NotNil(t, res, "unknown resource %s", name)
+	if tc.setup != nil {
+		tc.setup(server)
+	}
+
+	state := testApply(ctx, t, res, nil, tc.create, client)
+	var id int
+	if tc.collection != "" {
+		var err error
+		id, err = strconv.Atoi(state.ID)
+		require.NoError(t, err)
+		assert.NotNil(t, server.Object(tc.collection, id))
+	}
+	testCheckState(t, res, state, tc.create)
+	testCheckNoDrift(ctx, t, res, state, tc.create, client)
+
+	state = testApply(ctx, t, res, state, tc.update, client)
+	testCheckState(t, res, state, tc.update)
+	testCheckNoDrift(ctx, t, res, state, tc.update, client)
+
+	importID := state.ID
+	if tc.importID != "" {
+		importID = tc.importID
+	}
+	importConfig := maps.Clone(tc.update)
+	for _, key := range tc.importIgnore {
+		delete(importConfig, key)
+	}
+	imported := testImport(ctx, t, res, importID, client)
+	testCheckState(t, res, imported, importConfig)
+	testCheckNoDrift(ctx, t, res, imported, importConfig, client)
+
+	_, diags := res.Apply(ctx, state, &terraform.InstanceDiff{Destroy: true}, client)
+	require.False(t, diags.HasError(), "destroy: %v", diags)
+	if tc.collection != "" {
+		assert.Nil(t, server.Object(tc.collection, id))
+	}
+	if tc.kept {
+		return
+	}
+
+	// A resource deleted outside of Terraform is removed from the state.
+	refreshed, diags := res.RefreshWithoutUpgrade(ctx, state, client)
+	assert.False(t, diags.HasError(), "refresh: %v", diags)
+	assert.Nil(t, refreshed)
+}
+
+// testApply plans config against state and applies the plan.
+func testApply(ctx context.Context, t *testing.T, res *schema.Resource, state *terraform.InstanceState, config map[string]interface{}, client *hc.Client) *terraform.InstanceState {
+	t.Helper()
+
+	diff, err := res.Diff(ctx, testPriorState(t, res, state, config), terraform.NewResourceConfigRaw(config), client)
+	require.NoError(t, err)
+	require.NotNil(t, diff, "no changes planned")
+
+	newState, diags := res.Apply(ctx, state, diff, client)
+	require.False(t, diags.HasError(), "apply: %v", diags)
+	require.NotNil(t, newState)
+	require.NotEmpty(t, newState.ID)
+	return newState
+}
+
+// testImport imports the resource with the given ID and refreshes it.
+func testImport(ctx context.Context, t *testing.T, res *schema.Resource, id string, client *hc.Client) *terraform.InstanceState {
+	t.Helper()
+
+	data, err := res.Importer.StateContext(ctx, res.Data(&terraform.InstanceState{ID: id}), client)
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+	require.NotNil(t, data[0].State(), "import removed the resource")
+
+	state, diags := res.RefreshWithoutUpgrade(ctx, data[0].State(), client)
+	require.False(t, diags.HasError(), "refresh: %v", diags)
+	require.NotNil(t, state)
+	return state
+}
+
+// testCheckState checks that every configured attribute has its configured
+// value in state.
+func testCheckState(t *testing.T, res *schema.Resource, state *terraform.InstanceState, config map[string]interface{}) {
+	t.Helper()
+
+	want := schema.TestResourceDataRaw(t, res.Schema, config)
+	got := res.Data(state)
+	for key := range config {
+		if set, ok := want.Get(key).(*schema.Set); ok {
+			assert.True(t, set.Equal(got.Get(key)), "%s: want %v, got %v", key, set.List(), got.Get(key).(*schema.Set).List())
+			continue
+		}
+		assert.Equal(t, want.Get(key), got.Get(key), key)
+	}
+}
+
+// testCheckNoDrift checks that planning config against state again would
+// not change anything.
+func testCheckNoDrift(ctx context.Context, t *testing.T, res *schema.Resource, state *terraform.InstanceState, config map[string]interface{}, client *hc.Client) {
+	t.Helper()
+
+	diff, err := res.Diff(ctx, testPriorState(t, res, state, config), terraform.NewResourceConfigRaw(config), client)
+	require.NoError(t, err)
+	if diff != nil {
+		assert.Empty(t, diff.Attributes, "unexpected changes planned")
+	}
+}
+
+// testPriorState returns a copy of state that carries config as its raw
+// configuration, the way Terraform plans, so GetRawConfig works in
+// CustomizeDiff.
+func testPriorState(t *testing.T, res *schema.Resource, state *terraform.InstanceState, config map[string]interface{}) *terraform.InstanceState {
+	t.Helper()
+
+	data, err := json.Marshal(config)
+	require.NoError(t, err)
+	raw, err := ctyjson.Unmarshal(data, res.CoreConfigSchema().ImpliedType())
+	require.NoError(t, err)
+
+	prior := &terraform.InstanceState{}
+	if state != nil {
+		prior = state.DeepCopy()
+	}
+	prior.RawConfig = raw
+	return prior
+}
@@ -33,6 +33,7 @@ func resourceOU() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: hc.DefaultLabelsDiff,
 		Schema: map[string]*schema.Schema{
 			// Notice there is no 'id' field specified because it will be created.
 			"last_updated": {
@@ -92,8 +93,9 @@ func resourceOU() *schema.Resource {
 			"labels": {
 				Type:        schema.TypeMap,
 				Optional:    true,
+				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
-				Description: "A map of labels to assign to the OU. The labels must already exist in Kion.",
+				Description: "A map of labels to assign to the OU. The labels must already exist in Kion unless create_missing_labels is set on the provider.",
 			},
 		},
 	}
@@ -120,7 +122,7 @@ func resourceOUCreate(ctx context.Context, d *schema.ResourceData, m interface{}
 		PermissionSchemeID: d.Get("permission_scheme_id").(int),
 	}
 
-	resp, err := client.POST("/v3/ou", post)
+	resp, err := client.POSTContext(ctx, "/v3/ou", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -141,7 +143,7 @@ func resourceOUCreate(ctx context.Context, d *schema.ResourceData, m interface{}
 
 	if labels, ok := d.GetOk("labels"); ok && labels != nil {
 		ID := d.Id()
-		err = hc.PutAppLabelIDs(client, hc.FlattenAssociateLabels(d, "labels"), "ou", ID)
+		err = hc.PutAppLabelIDs(ctx, client, hc.FlattenAssociateLabels(d, "labels"), "ou", ID)
 
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
@@ -164,8 +166,11 @@ func resourceOURead(ctx context.Context, d *schema.ResourceData, m interface{})
 	ID := d.Id()
 
 	resp := new(hc.OUResponse)
-	err := client.GET(fmt.Sprintf("/v3/ou/%s", ID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/ou/%s", ID), resp)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_ou", err) {
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read OU",
@@ -201,7 +206,7 @@ func resourceOURead(ctx context.Context, d *schema.ResourceData, m interface{})
 	}
 
 	// Fetch labels
-	labelData, err := hc.ReadResourceLabels(client, "ou", ID)
+	labelData, err := hc.ReadResourceLabels(ctx, client, "ou", ID)
 
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
@@ -246,7 +251,7 @@ func resourceOUUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 			PermissionSchemeID: d.Get("permission_scheme_id").(int),
 		}
 
-		err := client.PATCH(fmt.Sprintf("/v3/ou/%s", ID), req)
+		err := client.PATCHContext(ctx, fmt.Sprintf("/v3/ou/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -259,7 +264,7 @@ func resourceOUUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 
 	// Allow moving an OU if the parent ID changes and updating permissions.
 	// Don't let codegen remove this.
-	diags, hasChanged = OUChanges(client, d, diags, hasChanged)
+	diags, hasChanged = OUChanges(ctx, client, d, diags, hasChanged)
 	if len(diags) > 0 {
 		return diags
 	}
@@ -273,7 +278,7 @@ func resourceOUUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 
 		if len(arrAddOwnerUserGroupIds) > 0 ||
 			len(arrAddOwnerUserIds) > 0 {
-			_, err := client.POST(fmt.Sprintf("/v3/ou/%s/owner", ID), hc.ChangeOwners{
+			_, err := client.POSTContext(ctx, fmt.Sprintf("/v3/ou/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -289,7 +294,7 @@ func resourceOUUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			err := client.DELETE(fmt.Sprintf("/v3/ou/%s/owner", ID), hc.ChangeOwners{
+			err := client.DELETEContext(ctx, fmt.Sprintf("/v3/ou/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -307,7 +312,7 @@ func resourceOUUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 	if d.HasChanges("labels") {
 		hasChanged++
 
-		err := hc.PutAppLabelIDs(client, hc.FlattenAssociateLabels(d, "labels"), "ou", ID)
+		err := hc.PutAppLabelIDs(ctx, client, hc.FlattenAssociateLabels(d, "labels"), "ou", ID)
 
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
@@ -338,7 +343,7 @@ func resourceOUDelete(ctx context.Context, d *schema.ResourceData, m interface{}
 	client := m.(*hc.Client)
 	ID := d.Id()
 
-	err := client.DELETE(fmt.Sprintf("/v2/ou/%s", ID), nil)
+	err := client.DELETEContext(ctx, fmt.Sprintf("/v2/ou/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -347,6 +352,7 @@ func resourceOUDelete(ctx context.Context, d *schema.ResourceData, m interface{}
 		})
 		return diags
 	}
+	diags = append(diags, hc.DeleteUnusedResourceLabels(ctx, client, d)...)
 
 	// d.SetId("") is automatically called assuming delete returns no errors, but
 	// it is added here for explicitness.
@@ -152,7 +152,7 @@ func resourceOUCloudAccessRoleCreate(ctx context.Context, d *schema.ResourceData
 	}
 
 	// Send the POST request
-	resp, err := client.POST("/v3/ou-cloud-access-role", post)
+	resp, err := client.POSTContext(ctx, "/v3/ou-cloud-access-role", post)
 	if err != nil {
 		return append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -186,8 +186,11 @@ func resourceOUCloudAccessRoleRead(ctx context.Context, d *schema.ResourceData,
 	ID := d.Id()
 
 	resp := new(hc.OUCloudAccessRoleResponse)
-	err := client.GET(fmt.Sprintf("/v3/ou-cloud-access-role/%s", ID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/ou-cloud-access-role/%s", ID), resp)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_ou_cloud_access_role", err) {
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read OUCloudAccessRole",
@@ -214,6 +217,7 @@ func resourceOUCloudAccessRoleRead(ctx context.Context, d *schema.ResourceData,
 		"aws_iam_policies":             hc.InflateObjectWithID(item.AwsIamPolicies),
 		"azure_role_definitions":       hc.InflateObjectWithID(item.AzureRoleDefinitions),
 		"gcp_iam_roles":                hc.InflateObjectWithID(item.GCPIamRoles),
+		"user_groups":                  hc.InflateObjectWithID(item.UserGroups),
 		"users":                        hc.InflateObjectWithID(item.Users),
 	}
 
@@ -251,7 +255,7 @@ func resourceOUCloudAccessRoleUpdate(ctx context.Context, d *schema.ResourceData
 			WebAccess:           d.Get("web_access").(bool),
 		}
 
-		if err := client.PATCH(fmt.Sprintf("/v3/ou-cloud-access-role/%s", ID), req); err != nil {
+		if err := client.PATCHContext(ctx, fmt.Sprintf("/v3/ou-cloud-access-role/%s", ID), req); err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
 				Summary:  "Unable to update OUCloudAccessRole",
@@ -299,7 +303,7 @@ func resourceOUCloudAccessRoleUpdate(ctx context.Context, d *schema.ResourceData
 		}
 
 		if addCarAssociation != (hc.OUCloudAccessRoleAssociationsAdd{}) {
-			if _, err := client.POST(fmt.Sprintf("/v3/ou-cloud-access-role/%s/association", ID), addCarAssociation); err != nil {
+			if _, err := client.POSTContext(ctx, fmt.Sprintf("/v3/ou-cloud-access-role/%s/association", ID), addCarAssociation); err != nil {
 				diags = append(diags, diag.Diagnostic{
 					Severity: diag.Error,
 					Summary:  "Unable to add associations on OUCloudAccessRole",
@@ -310,7 +314,7 @@ func resourceOUCloudAccessRoleUpdate(ctx context.Context, d *schema.ResourceData
 		}
 
 		if removeCarAssociation != (hc.OUCloudAccessRoleAssociationsRemove{}) {
-			if err := client.DELETE(fmt.Sprintf("/v3/ou-cloud-access-role/%s/association", ID), removeCarAssociation); err != nil {
+			if err := client.DELETEContext(ctx, fmt.Sprintf("/v3/ou-cloud-access-role/%s/association", ID), removeCarAssociation); err != nil {
 				diags = append(diags, diag.Diagnostic{
 					Severity: diag.Error,
 					Summary:  "Unable to remove associations on OUCloudAccessRole",
@@ -341,7 +345,7 @@ func resourceOUCloudAccessRoleDelete(ctx context.Context, d *schema.ResourceData
 	ID := d.Id()
 
 	// Make the DELETE request using the client and context
-	err := client.DELETE(fmt.Sprintf("/v3/ou-cloud-access-role/%s", ID), nil)
+	err := client.DELETEContext(ctx, fmt.Sprintf("/v3/ou-cloud-access-role/%s", ID), nil)
 	if err != nil {
 		// Add detailed diagnostic information on error
 		diags = append(diags, diag.Diagnostic{
@@ -74,7 +74,7 @@ func resourceOUPermissionMappingCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	// Make a PATCH request to the Kion API to create the permission mapping
-	err = client.PATCH(fmt.Sprintf("/v3/ou/%d/permission-mapping", ouID), []hc.OUPermissionMapping{mapping})
+	err = client.PATCHContext(ctx, fmt.Sprintf("/v3/ou/%d/permission-mapping", ouID), []hc.OUPermissionMapping{mapping})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -99,8 +99,11 @@ func resourceOUPermissionMappingRead(ctx context.Context, d *schema.ResourceData
 	ouID, appRoleID := ids[0], ids[1]
 
 	resp := new(hc.OUPermissionMappingListResponse)
-	err = client.GET(fmt.Sprintf("/v3/ou/%d/permission-mapping", ouID), resp)
+	err = client.GETContext(ctx, fmt.Sprintf("/v3/ou/%d/permission-mapping", ouID), resp)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_ou_permission_mapping", err) {
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
@@ -119,7 +122,7 @@ func resourceOUPermissionMappingRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	if !found {
-		d.SetId("")
+		hc.RemoveFromState(ctx, d, "kion_ou_permission_mapping")
 	}
 
 	return diags
@@ -144,7 +147,7 @@ func resourceOUPermissionMappingUpdate(ctx context.Context, d *schema.ResourceDa
 			UserIDs:       []int{},
 		}
 
-		err := client.PATCH(fmt.Sprintf("/v3/ou/%d/permission-mapping", ouID), []hc.OUPermissionMapping{oldMapping})
+		err := client.PATCHContext(ctx, fmt.Sprintf("/v3/ou/%d/permission-mapping", ouID), []hc.OUPermissionMapping{oldMapping})
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -170,7 +173,7 @@ func resourceOUPermissionMappingUpdate(ctx context.Context, d *schema.ResourceDa
 
 	// Fetch existing mappings from the API
 	resp := new(hc.OUPermissionMappingListResponse)
-	err = client.GET(fmt.Sprintf("/v3/ou/%d/permission-mapping", ouID), resp)
+	err = client.GETContext(ctx, fmt.Sprintf("/v3/ou/%d/permission-mapping", ouID), resp)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -196,7 +199,7 @@ func resourceOUPermissionMappingUpdate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	// Send the updated mappings to the Kion API
-	err = client.PATCH(fmt.Sprintf("/v3/ou/%d/permission-mapping", ouID), updatedMappings)
+	err = client.PATCHContext(ctx, fmt.Sprintf("/v3/ou/%d/permission-mapping", ouID), updatedMappings)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -225,7 +228,7 @@ func resourceOUPermissionMappingDelete(ctx context.Context, d *schema.ResourceDa
 	}
 
 	// Send the delete request to the Kion API
-	err = client.PATCH(fmt.Sprintf("/v3/ou/%d/permission-mapping", ouID), []hc.OUPermissionMapping{mapping})
+	err = client.PATCHContext(ctx, fmt.Sprintf("/v3/ou/%d/permission-mapping", ouID), []hc.OUPermissionMapping{mapping})
 	if err != nil {
 		return diag.FromErr(err)
 	}
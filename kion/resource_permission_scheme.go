@@ -0,0 +1,266 @@
+package kion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
+)
+
+func resourcePermissionScheme() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a permission scheme, which grants permissions to the app roles held on the OUs, projects, " +
+			"funding sources or cloud rules that use it, e.g. through their permission_scheme_id.",
+		CreateContext: resourcePermissionSchemeCreate,
+		ReadContext:   resourcePermissionSchemeRead,
+		UpdateContext: resourcePermissionSchemeUpdate,
+		DeleteContext: resourcePermissionSchemeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			// Notice there is no 'id' field specified because it will be created.
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"grant": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The permissions granted to an app role. Each app role may appear once.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_role_id": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The ID of the app role, e.g. 1 for Owner.",
+						},
+						"permission_ids": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "The IDs of the permissions granted to the app role.",
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"scheme_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The type of object the scheme applies to: " + strings.Join(hc.PermissionSchemeTypes, ", ") + ".",
+				ValidateFunc: validation.StringInSlice(hc.PermissionSchemeTypes, false),
+			},
+			"system": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the scheme is built into Kion.",
+			},
+		},
+		CustomizeDiff: validatePermissionSchemeGrants,
+	}
+}
+
+func resourcePermissionSchemeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*hc.Client)
+
+	post := hc.PermissionSchemeCreate{
+		Description: d.Get("description").(string),
+		Grants:      expandPermissionSchemeGrants(d.Get("grant").(*schema.Set)),
+		Name:        d.Get("name").(string),
+		SchemeType:  d.Get("scheme_type").(string),
+	}
+
+	resp, err := client.POSTContext(ctx, "/v3/permission-scheme", post)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create PermissionScheme",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), post),
+		})
+		return diags
+	} else if resp.RecordID == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create PermissionScheme",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", errors.New("received item ID of 0"), post),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(resp.RecordID))
+
+	return resourcePermissionSchemeRead(ctx, d, m)
+}
+
+func resourcePermissionSchemeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*hc.Client)
+	ID := d.Id()
+
+	resp := new(hc.PermissionSchemeResponse)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/permission-scheme/%s", ID), resp)
+	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_permission_scheme", err) {
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read PermissionScheme",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+	item := resp.Data
+
+	data := make(map[string]interface{})
+	data["description"] = item.Description
+	data["grant"] = flattenPermissionSchemeGrants(item.Grants)
+	data["name"] = item.Name
+	data["scheme_type"] = item.SchemeType
+	data["system"] = item.System
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read and set PermissionScheme",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourcePermissionSchemeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*hc.Client)
+	ID := d.Id()
+
+	// Determine if the attributes that are updatable are changed.
+	if d.HasChanges("description",
+		"grant",
+		"name") {
+		req := hc.PermissionSchemeUpdatable{
+			Description: d.Get("description").(string),
+			Grants:      expandPermissionSchemeGrants(d.Get("grant").(*schema.Set)),
+			Name:        d.Get("name").(string),
+		}
+
+		err := client.PATCHContext(ctx, fmt.Sprintf("/v3/permission-scheme/%s", ID), req)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update PermissionScheme",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+
+		if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to set last_updated",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return resourcePermissionSchemeRead(ctx, d, m)
+}
+
+func resourcePermissionSchemeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*hc.Client)
+	ID := d.Id()
+
+	err := client.DELETEContext(ctx, fmt.Sprintf("/v3/permission-scheme/%s", ID), nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete PermissionScheme",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+// validatePermissionSchemeGrants rejects an app role that is granted more
+// than once, as Kion keeps only one of its grants. Grants with unknown app
+// roles are checked once they are known.
+func validatePermissionSchemeGrants(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("grant") {
+		return nil
+	}
+	// An unknown app_role_id inside a grant leaves the set itself known and
+	// reads as 0, so check the configuration as well.
+	if raw := d.GetRawConfig(); !raw.IsNull() && !raw.GetAttr("grant").IsWhollyKnown() {
+		return nil
+	}
+
+	seen := make(map[int]bool)
+	for _, grant := range expandPermissionSchemeGrants(d.Get("grant").(*schema.Set)) {
+		if seen[grant.AppRoleID] {
+			return fmt.Errorf("app role %d is granted more than once; combine its permission_ids in one grant", grant.AppRoleID)
+		}
+		seen[grant.AppRoleID] = true
+	}
+	return nil
+}
+
+// expandPermissionSchemeGrants converts the grant blocks, ordered by app
+// role.
+func expandPermissionSchemeGrants(set *schema.Set) []hc.PermissionSchemeGrant {
+	grants := make([]hc.PermissionSchemeGrant, 0, set.Len())
+	for _, item := range set.List() {
+		grant := item.(map[string]interface{})
+		permissionIDs := *hc.FlattenIntArrayPointer(grant["permission_ids"].(*schema.Set).List())
+		sort.Ints(permissionIDs)
+		grants = append(grants, hc.PermissionSchemeGrant{
+			AppRoleID:     grant["app_role_id"].(int),
+			PermissionIDs: permissionIDs,
+		})
+	}
+	sort.Slice(grants, func(i, j int) bool {
+		return grants[i].AppRoleID < grants[j].AppRoleID
+	})
+	return grants
+}
+
+func flattenPermissionSchemeGrants(grants []hc.PermissionSchemeGrant) []interface{} {
+	out := make([]interface{}, 0, len(grants))
+	for _, grant := range grants {
+		out = append(out, map[string]interface{}{
+			"app_role_id":    grant.AppRoleID,
+			"permission_ids": grant.PermissionIDs,
+		})
+	}
+	return out
+}
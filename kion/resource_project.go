@@ -50,7 +50,7 @@ func resourceProject() *schema.Resource {
 					}
 				}
 			}
-			return nil
+			return hc.DefaultLabelsDiff(ctx, diff, meta)
 		},
 		Schema: map[string]*schema.Schema{
 			// Notice there is no 'id' field specified because it will be created.
@@ -242,8 +242,9 @@ func resourceProject() *schema.Resource {
 			"labels": {
 				Type:        schema.TypeMap,
 				Optional:    true,
+				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
-				Description: "A map of labels to assign to the project. The labels must already exist in Kion.",
+				Description: "A map of labels to assign to the project. The labels must already exist in Kion unless create_missing_labels is set on the provider.",
 			},
 		},
 	}
@@ -273,7 +274,7 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interf
 		} `json:"data"`
 	}
 	var config FinancialConfig
-	err := client.GET("/v1/ct-config/financials-config", &config)
+	err := client.GETContext(ctx, "/v1/ct-config/financials-config", &config)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -354,7 +355,7 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interf
 		}
 	}
 
-	resp, err := client.POST(fmt.Sprintf("/v3/project/%v", projectCreateURLSuffix), post)
+	resp, err := client.POSTContext(ctx, fmt.Sprintf("/v3/project/%v", projectCreateURLSuffix), post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -375,7 +376,7 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	if labels, ok := d.GetOk("labels"); ok && labels != nil {
 		ID := d.Id()
-		err = hc.PutAppLabelIDs(client, hc.FlattenAssociateLabels(d, "labels"), "project", ID)
+		err = hc.PutAppLabelIDs(ctx, client, hc.FlattenAssociateLabels(d, "labels"), "project", ID)
 
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
@@ -396,8 +397,11 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interfac
 	ID := d.Id()
 
 	resp := new(hc.ProjectResponse)
-	err := client.GET(fmt.Sprintf("/v3/project/%s", ID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/project/%s", ID), resp)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_project", err) {
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read Project",
@@ -421,7 +425,7 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interfac
 			BudgetMode bool `json:"budget_mode"`
 		} `json:"data"`
 	}
-	err = client.GET("/v3/app-config", &appConfig)
+	err = client.GETContext(ctx, "/v3/app-config", &appConfig)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -454,7 +458,7 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interfac
 			} `json:"data"`
 			Status int `json:"status"`
 		})
-		err = client.GET(fmt.Sprintf("/v3/project/%s/budget", ID), budgetResp)
+		err = client.GETContext(ctx, fmt.Sprintf("/v3/project/%s/budget", ID), budgetResp)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -538,7 +542,7 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interfac
 	}
 
 	// Fetch labels
-	labelData, err := hc.ReadResourceLabels(client, "project", ID)
+	labelData, err := hc.ReadResourceLabels(ctx, client, "project", ID)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -674,7 +678,7 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interf
 			PermissionSchemeID: d.Get("permission_scheme_id").(int),
 		}
 
-		err := client.PATCH(fmt.Sprintf("/v3/project/%s", ID), req)
+		err := client.PATCHContext(ctx, fmt.Sprintf("/v3/project/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -698,7 +702,7 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	// Handle budget changes
 	if d.HasChange("budget") {
 		hasChanged++
-		if budgetDiags := handleBudgetUpdate(d, client, ID); len(budgetDiags) > 0 {
+		if budgetDiags := handleBudgetUpdate(ctx, d, client, ID); len(budgetDiags) > 0 {
 			return budgetDiags
 		}
 	}
@@ -714,7 +718,7 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interf
 			len(arrAddOwnerUserIds) > 0 ||
 			len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			_, err := client.POST(fmt.Sprintf("/v1/project/%s/owner", ID), hc.ChangeOwners{
+			_, err := client.POSTContext(ctx, fmt.Sprintf("/v1/project/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -732,7 +736,7 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	if d.HasChanges("labels") {
 		hasChanged++
 
-		err := hc.PutAppLabelIDs(client, hc.FlattenAssociateLabels(d, "labels"), "project", ID)
+		err := hc.PutAppLabelIDs(ctx, client, hc.FlattenAssociateLabels(d, "labels"), "project", ID)
 
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
@@ -754,7 +758,7 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	return resourceProjectRead(ctx, d, m)
 }
 
-func handleBudgetUpdate(d *schema.ResourceData, client *hc.Client, projectID string) diag.Diagnostics {
+func handleBudgetUpdate(ctx context.Context, d *schema.ResourceData, client *hc.Client, projectID string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	// Get current budgets from Kion
@@ -768,7 +772,7 @@ func handleBudgetUpdate(d *schema.ResourceData, client *hc.Client, projectID str
 		} `json:"data"`
 		Status int `json:"status"`
 	})
-	err := client.GET(fmt.Sprintf("/v3/project/%s/budget", projectID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/project/%s/budget", projectID), resp)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -856,7 +860,7 @@ func handleBudgetUpdate(d *schema.ResourceData, client *hc.Client, projectID str
 
 		// Update existing budget
 		if existingBudgetID != 0 {
-			err = client.PUT(fmt.Sprintf("/v3/budget/%d", existingBudgetID), budgetReq)
+			err = client.PUTContext(ctx, fmt.Sprintf("/v3/budget/%d", existingBudgetID), budgetReq)
 			if err != nil {
 				diags = append(diags, diag.Diagnostic{
 					Severity: diag.Error,
@@ -870,7 +874,7 @@ func handleBudgetUpdate(d *schema.ResourceData, client *hc.Client, projectID str
 
 	// Delete removed budgets
 	for budgetID := range existingBudgetIDs {
-		err = client.DELETE(fmt.Sprintf("/v3/budget/%d", budgetID), nil)
+		err = client.DELETEContext(ctx, fmt.Sprintf("/v3/budget/%d", budgetID), nil)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -906,7 +910,7 @@ func handleBudgetUpdate(d *schema.ResourceData, client *hc.Client, projectID str
 			return reqDiags
 		}
 
-		_, err = client.POST("/v3/budget", budgetReq)
+		_, err = client.POSTContext(ctx, "/v3/budget", budgetReq)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -981,7 +985,7 @@ func handleProjectOUMove(ctx context.Context, d *schema.ResourceData, client *hc
 	})
 
 	// Call the move endpoint
-	_, err = client.POST(fmt.Sprintf("/v2/project/%s/move", projectID), moveCmd)
+	_, err = client.POSTContext(ctx, fmt.Sprintf("/v2/project/%s/move", projectID), moveCmd)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -1021,7 +1025,7 @@ func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, m interf
 		"project_id": projectID,
 	})
 
-	accountIDs, err := getAccountIDsForProject(client, projectID)
+	accountIDs, err := getAccountIDsForProject(ctx, client, projectID)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -1044,7 +1048,7 @@ func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, m interf
 				"project_id": projectID,
 			})
 
-			_, err := convertProjectAccountToCacheAccount(client, accountID)
+			_, err := convertProjectAccountToCacheAccount(ctx, client, accountID)
 			if err != nil {
 				diags = append(diags, diag.Diagnostic{
 					Severity: diag.Error,
@@ -1061,7 +1065,7 @@ func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, m interf
 		})
 	}
 
-	err = client.DELETE(fmt.Sprintf("/v3/project/%s", ID), nil)
+	err = client.DELETEContext(ctx, fmt.Sprintf("/v3/project/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -1070,6 +1074,7 @@ func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, m interf
 		})
 		return diags
 	}
+	diags = append(diags, hc.DeleteUnusedResourceLabels(ctx, client, d)...)
 
 	// d.SetId("") is automatically called assuming delete returns no errors, but
 	// it is added here for explicitness.
@@ -1079,9 +1084,9 @@ func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 // getAccountIDsForProject fetches all account IDs attached to a given project.
-func getAccountIDsForProject(client *hc.Client, projectID int) ([]int, error) {
+func getAccountIDsForProject(ctx context.Context, client *hc.Client, projectID int) ([]int, error) {
 	resp := new(hc.AccountListResponse)
-	if err := client.GET("/v3/account", resp); err != nil {
+	if err := hc.ListInto(ctx, client, "/v3/account", nil, &resp.Data); err != nil {
 		return nil, fmt.Errorf("failed to fetch accounts: %v", err)
 	}
 
@@ -181,7 +181,7 @@ func resourceProjectCloudAccessRoleCreate(ctx context.Context, d *schema.Resourc
 	}
 
 	// Send the POST request
-	resp, err := client.POST("/v3/project-cloud-access-role", post)
+	resp, err := client.POSTContext(ctx, "/v3/project-cloud-access-role", post)
 	if err != nil {
 		return append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -215,8 +215,11 @@ func resourceProjectCloudAccessRoleRead(ctx context.Context, d *schema.ResourceD
 	ID := d.Id()
 
 	resp := new(hc.ProjectCloudAccessRoleResponse)
-	err := client.GET(fmt.Sprintf("/v3/project-cloud-access-role/%s", ID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/project-cloud-access-role/%s", ID), resp)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_project_cloud_access_role", err) {
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read ProjectCloudAccessRole",
@@ -283,7 +286,7 @@ func resourceProjectCloudAccessRoleUpdate(ctx context.Context, d *schema.Resourc
 			WebAccess:           d.Get("web_access").(bool),
 		}
 
-		if err := client.PATCH(fmt.Sprintf("/v3/project-cloud-access-role/%s", ID), req); err != nil {
+		if err := client.PATCHContext(ctx, fmt.Sprintf("/v3/project-cloud-access-role/%s", ID), req); err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
 				Summary:  "Unable to update ProjectCloudAccessRole",
@@ -336,7 +339,7 @@ func resourceProjectCloudAccessRoleUpdate(ctx context.Context, d *schema.Resourc
 		}
 
 		if addCarAssociation != (hc.ProjectCloudAccessRoleAssociationsAdd{}) {
-			if _, err := client.POST(fmt.Sprintf("/v3/project-cloud-access-role/%s/association", ID), addCarAssociation); err != nil {
+			if _, err := client.POSTContext(ctx, fmt.Sprintf("/v3/project-cloud-access-role/%s/association", ID), addCarAssociation); err != nil {
 				diags = append(diags, diag.Diagnostic{
 					Severity: diag.Error,
 					Summary:  "Unable to add associations on ProjectCloudAccessRole",
@@ -347,7 +350,7 @@ func resourceProjectCloudAccessRoleUpdate(ctx context.Context, d *schema.Resourc
 		}
 
 		if removeCarAssociation != (hc.ProjectCloudAccessRoleAssociationsRemove{}) {
-			if err := client.DELETE(fmt.Sprintf("/v3/project-cloud-access-role/%s/association", ID), removeCarAssociation); err != nil {
+			if err := client.DELETEContext(ctx, fmt.Sprintf("/v3/project-cloud-access-role/%s/association", ID), removeCarAssociation); err != nil {
 				diags = append(diags, diag.Diagnostic{
 					Severity: diag.Error,
 					Summary:  "Unable to remove associations on ProjectCloudAccessRole",
@@ -378,7 +381,7 @@ func resourceProjectCloudAccessRoleDelete(ctx context.Context, d *schema.Resourc
 	ID := d.Id()
 
 	// Make the DELETE request using the client and context
-	err := client.DELETE(fmt.Sprintf("/v3/project-cloud-access-role/%s", ID), nil)
+	err := client.DELETEContext(ctx, fmt.Sprintf("/v3/project-cloud-access-role/%s", ID), nil)
 	if err != nil {
 		// Add detailed diagnostic information on error
 		diags = append(diags, diag.Diagnostic{
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,13 +19,15 @@ func resourceProjectEnforcement() *schema.Resource {
 	return &schema.Resource{
 		Description: "Manages enforcement rules for projects to control service usage based on various criteria like" +
 			"spend limits and timeframe restrictions. .\n\n" +
-			"This resource allows for creating, reading, updating, and deleting project-specific enforcement settings.",
+			"This resource allows for creating, reading, updating, and deleting project-specific enforcement settings.\n\n" +
+			"Import an enforcement with an ID of the form `project_id/enforcement_id`. " +
+			"Importing by the enforcement ID alone also works, but searches every project for it.",
 		CreateContext: resourceProjectEnforcementCreate,
 		ReadContext:   resourceProjectEnforcementRead,
 		UpdateContext: resourceProjectEnforcementUpdate,
 		DeleteContext: resourceProjectEnforcementDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceProjectEnforcementImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"description": {
@@ -167,7 +170,7 @@ func resourceProjectEnforcementCreate(ctx context.Context, d *schema.ResourceDat
 	projectEnforcementURL := fmt.Sprintf("/v3/project/%d/enforcement", projectIDInt)
 
 	// Send the create request
-	resp, err := client.POST(projectEnforcementURL, post)
+	resp, err := client.POSTContext(ctx, projectEnforcementURL, post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -205,15 +208,14 @@ func resourceProjectEnforcementRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	resp := new(hc.ProjectEnforcementResponse)
-	err = client.GET(fmt.Sprintf("/v3/project/%d/enforcement", projectID), resp)
+	err = client.GETContext(ctx, fmt.Sprintf("/v3/project/%d/enforcement", projectID), resp)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_project_enforcement", err) {
+			return diags
+		}
 		return diag.FromErr(err)
 	}
 
-	if len(resp.Data) == 0 {
-		return diag.Errorf("No Project Enforcement data found for Project ID %d", projectID)
-	}
-
 	var found bool
 	for _, item := range resp.Data {
 		if int(item.ID) == enforcementIDInt {
@@ -233,7 +235,7 @@ func resourceProjectEnforcementRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if !found {
-		return diag.Errorf("Enforcement ID %d not found under Project ID %d", enforcementIDInt, projectID)
+		hc.RemoveFromState(ctx, d, "kion_project_enforcement")
 	}
 
 	return diags
@@ -267,7 +269,7 @@ func AddProjectEnforcementUsers(ctx context.Context, d *schema.ResourceData, m i
 	}
 
 	endpoint := fmt.Sprintf("/v3/project/%d/enforcement/%s/user", projectIDInt, enforcementID)
-	_, err := client.POST(endpoint, req)
+	_, err := client.POSTContext(ctx, endpoint, req)
 	if err != nil {
 		return diag.Errorf("Error adding users/user groups in Project Enforcement: %v", err)
 	}
@@ -315,7 +317,7 @@ func RemoveProjectEnforcementUsers(ctx context.Context, d *schema.ResourceData,
 	}
 
 	endpoint := fmt.Sprintf("/v3/project/%d/enforcement/%s/user", projectIDInt, enforcementID)
-	err = client.DELETE(endpoint, req)
+	err = client.DELETEContext(ctx, endpoint, req)
 	if err != nil {
 		return diag.Errorf("Error removing users/user groups in Project Enforcement: %v", err)
 	}
@@ -356,7 +358,7 @@ func resourceProjectEnforcementUpdate(ctx context.Context, d *schema.ResourceDat
 
 		// Send the update request
 		endpoint := fmt.Sprintf("/v3/project/%d/enforcement/%s", projectIDInt, enforcementID)
-		err := client.PATCH(endpoint, req)
+		err := client.PATCHContext(ctx, endpoint, req)
 		if err != nil {
 			return diag.Errorf("Unable to update Project Enforcement: %v", err)
 		}
@@ -402,7 +404,7 @@ func resourceProjectEnforcementDelete(ctx context.Context, d *schema.ResourceDat
 
 	// Preparing the endpoint URL
 	endpoint := fmt.Sprintf("/v3/project/%d/enforcement/%s", projectIDInt, enforcementID)
-	err := client.DELETE(endpoint, nil)
+	err := client.DELETEContext(ctx, endpoint, nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -417,3 +419,60 @@ func resourceProjectEnforcementDelete(ctx context.Context, d *schema.ResourceDat
 
 	return diags
 }
+
+// resourceProjectEnforcementImport sets the project the read depends on from
+// an import ID of the form project_id/enforcement_id. An import ID of just the
+// enforcement ID is still accepted, and the project is looked up in Kion.
+func resourceProjectEnforcementImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	projectID, enforcementID, ok := strings.Cut(d.Id(), "/")
+	if !ok {
+		return resourceProjectEnforcementImportByID(ctx, d, m.(*hc.Client))
+	}
+	if projectID == "" || enforcementID == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected project_id/enforcement_id", d.Id())
+	}
+
+	projectIDInt, err := strconv.Atoi(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid project ID %q in import ID: %w", projectID, err)
+	}
+	if err := d.Set("project_id", projectIDInt); err != nil {
+		return nil, err
+	}
+	d.SetId(enforcementID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceProjectEnforcementImportByID finds the project of an enforcement
+// imported by its ID alone by searching the enforcements of every project.
+func resourceProjectEnforcementImportByID(ctx context.Context, d *schema.ResourceData, client *hc.Client) ([]*schema.ResourceData, error) {
+	enforcementID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("invalid import ID %q, expected project_id/enforcement_id", d.Id())
+	}
+
+	var projects hc.ProjectListResponse
+	if err := hc.ListInto(ctx, client, "/v3/project", nil, &projects.Data); err != nil {
+		return nil, fmt.Errorf("error getting projects: %w", err)
+	}
+
+	for _, project := range projects.Data {
+		resp := new(hc.ProjectEnforcementResponse)
+		err := client.GETContext(ctx, fmt.Sprintf("/v3/project/%d/enforcement", project.ID), resp)
+		if err != nil {
+			return nil, fmt.Errorf("error getting enforcements of project %d: %w", project.ID, err)
+		}
+
+		for _, item := range resp.Data {
+			if int(item.ID) == enforcementID {
+				if err := d.Set("project_id", project.ID); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("project enforcement %d not found", enforcementID)
+}
@@ -3,7 +3,6 @@ package kion
 import (
 	"context"
 	"fmt"
-	"net/url"
 	"strconv"
 	"time"
 
@@ -80,7 +79,7 @@ func resourceProjectNoteCreate(ctx context.Context, d *schema.ResourceData, m in
 		Text:         d.Get("text").(string),
 	}
 
-	resp, err := client.POST("/v3/project-note", post)
+	resp, err := client.POSTContext(ctx, "/v3/project-note", post)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to create Project Note: %v", err))
 	} else if resp.RecordID == 0 {
@@ -99,11 +98,15 @@ func resourceProjectNoteRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	// Get project notes with project_id filter
 	resp := new(hc.ProjectNoteListResponse)
-	params := url.Values{}
-	params.Add("project_id", strconv.Itoa(d.Get("project_id").(int)))
+	params := map[string]string{
+		"project_id": strconv.Itoa(d.Get("project_id").(int)),
+	}
 
-	err := client.GET(fmt.Sprintf("/v3/project-note?%s", params.Encode()), resp)
+	err := hc.ListInto(ctx, client, "/v3/project-note", params, &resp.Data)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_project_note", err) {
+			return diags
+		}
 		return diag.FromErr(fmt.Errorf("unable to read Project Note: %v", err))
 	}
 
@@ -131,7 +134,7 @@ func resourceProjectNoteRead(ctx context.Context, d *schema.ResourceData, m inte
 	}
 
 	if !found {
-		d.SetId("")
+		hc.RemoveFromState(ctx, d, "kion_project_note")
 		return nil
 	}
 
@@ -144,10 +147,11 @@ func resourceProjectNoteUpdate(ctx context.Context, d *schema.ResourceData, m in
 
 	// Get current state to populate required fields
 	resp := new(hc.ProjectNoteListResponse)
-	params := url.Values{}
-	params.Add("project_id", strconv.Itoa(d.Get("project_id").(int)))
+	params := map[string]string{
+		"project_id": strconv.Itoa(d.Get("project_id").(int)),
+	}
 
-	err := client.GET(fmt.Sprintf("/v3/project-note?%s", params.Encode()), resp)
+	err := hc.ListInto(ctx, client, "/v3/project-note", params, &resp.Data)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to read Project Note for update: %v", err))
 	}
@@ -175,7 +179,7 @@ func resourceProjectNoteUpdate(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	// Use v2 endpoint for update
-	err = client.PATCH(fmt.Sprintf("/v2/project-note/%s", ID), patch)
+	err = client.PATCHContext(ctx, fmt.Sprintf("/v2/project-note/%s", ID), patch)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to update Project Note: %v", err))
 	}
@@ -193,11 +197,12 @@ func resourceProjectNoteDelete(ctx context.Context, d *schema.ResourceData, m in
 	ID := d.Id()
 
 	// For delete, we'll need to find the note first
-	params := url.Values{}
-	params.Add("project_id", strconv.Itoa(d.Get("project_id").(int)))
+	params := map[string]string{
+		"project_id": strconv.Itoa(d.Get("project_id").(int)),
+	}
 
 	resp := new(hc.ProjectNoteListResponse)
-	err := client.GET(fmt.Sprintf("/v3/project-note?%s", params.Encode()), resp)
+	err := hc.ListInto(ctx, client, "/v3/project-note", params, &resp.Data)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to find Project Note for deletion: %v", err))
 	}
@@ -217,7 +222,7 @@ func resourceProjectNoteDelete(ctx context.Context, d *schema.ResourceData, m in
 		return nil
 	}
 
-	err = client.DELETE(fmt.Sprintf("/v3/project-note/%s", ID), nil)
+	err = client.DELETEContext(ctx, fmt.Sprintf("/v3/project-note/%s", ID), nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to delete Project Note: %v", err))
 	}
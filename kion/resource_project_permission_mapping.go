@@ -73,7 +73,7 @@ func resourceProjectPermissionsMappingCreate(ctx context.Context, d *schema.Reso
 	}
 
 	// Make a PATCH request to the Kion API to create the permission mapping
-	err = client.PATCH(fmt.Sprintf("/v3/project/%d/permission-mapping", projectID), []hc.ProjectPermissionMapping{mapping})
+	err = client.PATCHContext(ctx, fmt.Sprintf("/v3/project/%d/permission-mapping", projectID), []hc.ProjectPermissionMapping{mapping})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -98,8 +98,11 @@ func resourceProjectPermissionsMappingRead(ctx context.Context, d *schema.Resour
 	projectID, appRoleID := ids[0], ids[1]
 
 	resp := new(hc.ProjectPermissionMappingListResponse)
-	err = client.GET(fmt.Sprintf("/v3/project/%d/permission-mapping", projectID), resp)
+	err = client.GETContext(ctx, fmt.Sprintf("/v3/project/%d/permission-mapping", projectID), resp)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_project_permission_mapping", err) {
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
@@ -118,7 +121,7 @@ func resourceProjectPermissionsMappingRead(ctx context.Context, d *schema.Resour
 	}
 
 	if !found {
-		d.SetId("")
+		hc.RemoveFromState(ctx, d, "kion_project_permission_mapping")
 	}
 
 	return diags
@@ -143,7 +146,7 @@ func resourceProjectPermissionsMappingUpdate(ctx context.Context, d *schema.Reso
 			UserIDs:       []int{},
 		}
 
-		err := client.PATCH(fmt.Sprintf("/v3/project/%d/permission-mapping", projectID), []hc.ProjectPermissionMapping{oldMapping})
+		err := client.PATCHContext(ctx, fmt.Sprintf("/v3/project/%d/permission-mapping", projectID), []hc.ProjectPermissionMapping{oldMapping})
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -169,7 +172,7 @@ func resourceProjectPermissionsMappingUpdate(ctx context.Context, d *schema.Reso
 
 	// Fetch existing mappings from the API
 	resp := new(hc.ProjectPermissionMappingListResponse)
-	err = client.GET(fmt.Sprintf("/v3/project/%d/permission-mapping", projectID), resp)
+	err = client.GETContext(ctx, fmt.Sprintf("/v3/project/%d/permission-mapping", projectID), resp)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -195,7 +198,7 @@ func resourceProjectPermissionsMappingUpdate(ctx context.Context, d *schema.Reso
 	}
 
 	// Send the updated mappings to the Kion API
-	err = client.PATCH(fmt.Sprintf("/v3/project/%d/permission-mapping", projectID), updatedMappings)
+	err = client.PATCHContext(ctx, fmt.Sprintf("/v3/project/%d/permission-mapping", projectID), updatedMappings)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -224,7 +227,7 @@ func resourceProjectPermissionsMappingDelete(ctx context.Context, d *schema.Reso
 	}
 
 	// Send the delete request to the Kion API
-	err = client.PATCH(fmt.Sprintf("/v3/project/%d/permission-mapping", projectID), []hc.ProjectPermissionMapping{mapping})
+	err = client.PATCHContext(ctx, fmt.Sprintf("/v3/project/%d/permission-mapping", projectID), []hc.ProjectPermissionMapping{mapping})
 	if err != nil {
 		return diag.FromErr(err)
 	}
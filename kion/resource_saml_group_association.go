@@ -76,7 +76,7 @@ func resourceSamlGroupAssociationCreate(ctx context.Context, d *schema.ResourceD
 		UserGroupID:    d.Get("user_group_id").(int),
 	}
 
-	resp, err := client.POST("/v3/idms/group-association", post)
+	resp, err := client.POSTContext(ctx, "/v3/idms/group-association", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -106,8 +106,11 @@ func resourceSamlGroupAssociationRead(ctx context.Context, d *schema.ResourceDat
 	ID := d.Id()
 
 	resp := new(hc.GroupAssociationResponse)
-	err := client.GET(fmt.Sprintf("/v3/idms/group-association/%s", ID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/idms/group-association/%s", ID), resp)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_saml_group_association", err) {
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read SamlGroupAssociation",
@@ -123,6 +126,7 @@ func resourceSamlGroupAssociationRead(ctx context.Context, d *schema.ResourceDat
 	data["idms_id"] = item.IdmsID
 	data["idms_saml_id"] = item.IdmsSamlID
 	data["should_update_on_login"] = item.ShouldUpdateOnLogin
+	data["update_on_login"] = item.ShouldUpdateOnLogin
 	data["user_group_id"] = item.UserGroupID
 
 	for k, v := range data {
@@ -162,7 +166,7 @@ func resourceSamlGroupAssociationUpdate(ctx context.Context, d *schema.ResourceD
 			UserGroupID:    d.Get("user_group_id").(int),
 		}
 
-		err := client.PATCH(fmt.Sprintf("/v3/idms/group-association/%s", ID), req)
+		err := client.PATCHContext(ctx, fmt.Sprintf("/v3/idms/group-association/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -192,7 +196,7 @@ func resourceSamlGroupAssociationDelete(ctx context.Context, d *schema.ResourceD
 	client := m.(*hc.Client)
 	ID := d.Id()
 
-	err := client.DELETE(fmt.Sprintf("/v3/idms/group-association/%s", ID), nil)
+	err := client.DELETEContext(ctx, fmt.Sprintf("/v3/idms/group-association/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
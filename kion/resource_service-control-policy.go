@@ -99,7 +99,7 @@ func resourceServiceControlPolicyCreate(ctx context.Context, d *schema.ResourceD
 		Policy:            d.Get("policy").(string),
 	}
 
-	resp, err := client.POST("/v3/service-control-policy", post)
+	resp, err := client.POSTContext(ctx, "/v3/service-control-policy", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -129,8 +129,11 @@ func resourceServiceControlPolicyRead(ctx context.Context, d *schema.ResourceDat
 	ID := d.Id()
 
 	resp := new(hc.ServiceControlPolicyResponse)
-	err := client.GET(fmt.Sprintf("/v3/service-control-policy/%s", ID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/service-control-policy/%s", ID), resp)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_service_control_policy", err) {
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read Service_control_policy",
@@ -189,7 +192,7 @@ func resourceServiceControlPolicyUpdate(ctx context.Context, d *schema.ResourceD
 			Policy:      d.Get("policy").(string),
 		}
 
-		err := client.PATCH(fmt.Sprintf("/v3/service-control-policy/%s", ID), req)
+		err := client.PATCHContext(ctx, fmt.Sprintf("/v3/service-control-policy/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -209,7 +212,7 @@ func resourceServiceControlPolicyUpdate(ctx context.Context, d *schema.ResourceD
 
 		if len(arrAddOwnerUserGroupIds) > 0 ||
 			len(arrAddOwnerUserIds) > 0 {
-			_, err := client.POST(fmt.Sprintf("/v3/service-control-policy/%s/owner", ID), hc.ChangeOwners{
+			_, err := client.POSTContext(ctx, fmt.Sprintf("/v3/service-control-policy/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -225,7 +228,7 @@ func resourceServiceControlPolicyUpdate(ctx context.Context, d *schema.ResourceD
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			err := client.DELETE(fmt.Sprintf("/v3/service-control-policy/%s/owner", ID), hc.ChangeOwners{
+			err := client.DELETEContext(ctx, fmt.Sprintf("/v3/service-control-policy/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -259,7 +262,7 @@ func resourceServiceControlPolicyDelete(ctx context.Context, d *schema.ResourceD
 	client := m.(*hc.Client)
 	ID := d.Id()
 
-	err := client.DELETE(fmt.Sprintf("/v3/service-control-policy/%s", ID), nil)
+	err := client.DELETEContext(ctx, fmt.Sprintf("/v3/service-control-policy/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -2,52 +2,353 @@ package kion
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
 )
 
+// localIdmsID is the ID of the IDMS of users that log in with a Kion password.
+const localIdmsID = 1
+
 func resourceUser() *schema.Resource {
 	return &schema.Resource{
+		Description: "Manages a Kion user, such as a break-glass or service account that logs in with a Kion password. " +
+			"Users of an external IDMS are usually created by Kion when they first log in and only need to be imported.",
+		CreateContext: resourceUserCreate,
 		ReadContext:   resourceUserRead,
+		UpdateContext: resourceUserUpdate,
 		DeleteContext: resourceUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserImport,
+		},
 		Schema: map[string]*schema.Schema{
-			"id": {
+			// Notice there is no 'id' field specified because it will be created.
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disable_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable the user instead of deleting it on destroy, which keeps its history in Kion.",
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the user can log in.",
+			},
+			"first_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"idms_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     localIdmsID,
+				ForceNew:    true,
+				Description: "The ID of the IDMS the user logs in with. Defaults to 1, the local Kion IDMS.",
+			},
+			"last_login": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"last_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password_wo"},
+				Description:   "The password of a local user. Kion does not return it, so changes made outside of Terraform are not detected; changing it here sets a new password. Use password_wo to keep the password out of the state.",
+			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"password"},
+				RequiredWith:  []string{"password_wo_version"},
+				Description:   "The password of a local user. The password is never stored in the plan or state and requires Terraform 1.11 or later.",
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The version of password_wo. Changing it sets password_wo as the new password.",
+			},
+			"phone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"require_password_reset": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the user must choose a new password at the next login after password was set or changed.",
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("password"), cty.GetAttrPath("password_wo")),
 		},
 	}
 }
 
-func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	client := m.(*hc.Client)
-	idString := d.Get("id").(string)
-	ID, err := strconv.Atoi(idString)
 
+	post := hc.UserCreate{
+		Email:                d.Get("email").(string),
+		Enabled:              d.Get("enabled").(bool),
+		FirstName:            d.Get("first_name").(string),
+		IdmsID:               d.Get("idms_id").(int),
+		LastName:             d.Get("last_name").(string),
+		Password:             d.Get("password").(string),
+		Phone:                d.Get("phone").(string),
+		RequirePasswordReset: d.Get("require_password_reset").(bool),
+		Username:             d.Get("username").(string),
+	}
+
+	password, err := hc.WriteOnlyString(d, "password_wo")
 	if err != nil {
-		return diag.Errorf("Error converting ID to integer: %s", err)
+		return diag.FromErr(err)
+	} else if password != "" {
+		post.Password = password
 	}
 
+	resp, err := client.POSTContext(ctx, "/v3/user", post)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create User",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), post.Username),
+		})
+		return diags
+	} else if resp.RecordID == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create User",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", errors.New("received item ID of 0"), post.Username),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(resp.RecordID))
+
+	return resourceUserRead(ctx, d, m)
+}
+
+func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*hc.Client)
+	ID := d.Id()
+
 	resp := new(hc.UserResponse)
-	err = client.GET(fmt.Sprintf("/v3/user/%d", ID), resp)
-	if diags := hc.HandleError(err); diags != nil {
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/user/%s", ID), resp)
+	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_user", err) {
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read User",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
 		return diags
 	}
+	item := resp.Data.User
+
+	data := make(map[string]interface{})
+	data["created_at"] = item.CreatedAt
+	data["email"] = item.Email
+	data["enabled"] = item.Enabled
+	data["first_name"] = item.FirstName
+	data["idms_id"] = item.IdmsID
+	data["last_login"] = item.LastLogin
+	data["last_name"] = item.LastName
+	data["phone"] = item.Phone
+	data["username"] = item.Username
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read and set User",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*hc.Client)
+	ID := d.Id()
+
+	hasChanged := 0
+
+	// Determine if the attributes that are updatable are changed.
+	if d.HasChanges("email",
+		"enabled",
+		"first_name",
+		"last_name",
+		"phone",
+		"username") {
+		hasChanged++
+		req := hc.UserUpdatable{
+			Email:     d.Get("email").(string),
+			Enabled:   d.Get("enabled").(bool),
+			FirstName: d.Get("first_name").(string),
+			LastName:  d.Get("last_name").(string),
+			Phone:     d.Get("phone").(string),
+			Username:  d.Get("username").(string),
+		}
+
+		err := client.PATCHContext(ctx, fmt.Sprintf("/v3/user/%s", ID), req)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update User",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	// A removed password keeps the current one, as Kion has no way to unset it.
+	// password_wo is only sent when its version changes.
+	var password string
+	if d.HasChange("password") {
+		password = d.Get("password").(string)
+	} else if d.HasChange("password_wo_version") {
+		wo, err := hc.WriteOnlyString(d, "password_wo")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		password = wo
+	}
+	if password != "" {
+		hasChanged++
+		req := hc.UserPassword{
+			Password:             password,
+			RequirePasswordReset: d.Get("require_password_reset").(bool),
+		}
 
-	userID := strconv.Itoa(resp.Data.User.ID)
-	d.SetId(userID)
+		err := client.PUTContext(ctx, fmt.Sprintf("/v3/user/%s/password", ID), req)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to set password of User",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	if hasChanged > 0 {
+		if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to set last_updated",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
 
-	return nil
+	return resourceUserRead(ctx, d, m)
 }
 
-// resourceUserDelete implements a no-op delete
 func resourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	// This function does nothing, but Terraform requires it.
-	// It clears the ID to signal that the resource has been "deleted" in Terraform's state.
+	var diags diag.Diagnostics
+	client := m.(*hc.Client)
+	ID := d.Id()
+
+	var err error
+	if d.Get("disable_on_destroy").(bool) {
+		err = client.PATCHContext(ctx, fmt.Sprintf("/v3/user/%s", ID), hc.UserUpdatable{
+			Email:     d.Get("email").(string),
+			Enabled:   false,
+			FirstName: d.Get("first_name").(string),
+			LastName:  d.Get("last_name").(string),
+			Phone:     d.Get("phone").(string),
+			Username:  d.Get("username").(string),
+		})
+	} else {
+		err = client.DELETEContext(ctx, fmt.Sprintf("/v3/user/%s", ID), nil)
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete User",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
 	d.SetId("")
-	return nil
+
+	return diags
+}
+
+// resourceUserImport accepts the ID or the username of the user.
+func resourceUserImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*hc.Client)
+
+	if _, err := strconv.Atoi(d.Id()); err != nil {
+		users, err := hc.List[hc.User](ctx, client, "/v3/user", nil)
+		if err != nil {
+			return nil, err
+		}
+		username := d.Id()
+		for _, user := range users {
+			if user.Username == username {
+				d.SetId(strconv.Itoa(user.ID))
+				break
+			}
+		}
+		if d.Id() == username {
+			return nil, fmt.Errorf("no user with the username %q", username)
+		}
+	}
+
+	// The defaults of attributes Kion does not return.
+	for k, v := range map[string]interface{}{
+		"disable_on_destroy":     false,
+		"require_password_reset": true,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return nil, err
+		}
+	}
+	return []*schema.ResourceData{d}, nil
 }
@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	hc "github.com/kionsoftware/terraform-provider-kion/kion/internal/kionclient"
 )
 
@@ -56,9 +58,26 @@ func resourceWebhook() *schema.Resource {
 				Description: "The request body to be sent with the webhook.",
 			},
 			"request_headers": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "HTTP headers to use when the webhook is triggered",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"request_headers_wo"},
+				Description:   "HTTP headers to use when the webhook is triggered. Use request_headers_wo to keep the headers out of the state.",
+			},
+			"request_headers_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"request_headers"},
+				RequiredWith:  []string{"request_headers_wo_version"},
+				Description:   "HTTP headers to use when the webhook is triggered. The headers are never stored in the plan or state and require Terraform 1.11 or later.",
+			},
+			"request_headers_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"request_headers_wo"},
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The version of request_headers_wo. The headers are only sent to Kion when the webhook is created and when the version changes.",
 			},
 			"request_method": {
 				Type:        schema.TypeString,
@@ -88,6 +107,9 @@ func resourceWebhook() *schema.Resource {
 		},
 		// Set the CustomizeDiff function
 		CustomizeDiff: validateOwnerFields,
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("request_headers"), cty.GetAttrPath("request_headers_wo")),
+		},
 	}
 }
 
@@ -97,6 +119,7 @@ func populateWebhook(d *schema.ResourceData) (hc.Webhook, error) {
 		CalloutURL:           d.Get("callout_url").(string),
 		Description:          d.Get("description").(string),
 		Name:                 d.Get("name").(string),
+		RequestMethod:        d.Get("request_method").(string),
 		ShouldSendSecureInfo: d.Get("should_send_secure_info").(bool),
 		SkipSSL:              d.Get("skip_ssl").(bool),
 		TimeoutInSeconds:     d.Get("timeout_in_seconds").(int),
@@ -121,6 +144,21 @@ func populateWebhook(d *schema.ResourceData) (hc.Webhook, error) {
 		}
 	}
 
+	// request_headers_wo is only sent on create and when its version changes,
+	// since the state cannot tell whether the headers themselves changed.
+	if d.IsNewResource() || d.HasChange("request_headers_wo_version") {
+		requestHeaders, err := hc.WriteOnlyString(d, "request_headers_wo")
+		if err != nil {
+			return hc.Webhook{}, err
+		}
+		if requestHeaders != "" {
+			webhook.RequestHeaders, err = normalizeJSONString(requestHeaders)
+			if err != nil {
+				return hc.Webhook{}, fmt.Errorf("error normalizing request_headers_wo: %w", err)
+			}
+		}
+	}
+
 	// Convert owner user IDs and handle potential errors
 	webhook.OwnerUserIDs, err = hc.ConvertInterfaceSliceToIntSlice(d.Get("owner_user_ids").(*schema.Set).List())
 	if err != nil {
@@ -166,7 +204,7 @@ func resourceWebhookCreate(ctx context.Context, d *schema.ResourceData, m interf
 	}
 
 	// Create webhook
-	resp, err := client.POST("/v3/webhook", webhook)
+	resp, err := client.POSTContext(ctx, "/v3/webhook", webhook)
 	if diags := hc.HandleError(err); diags != nil {
 		return diags
 	}
@@ -187,8 +225,11 @@ func resourceWebhookRead(ctx context.Context, d *schema.ResourceData, m interfac
 	resp := new(hc.WebhookWithOwnersResponse)
 
 	// Perform GET request to fetch webhook data
-	err := client.GET(fmt.Sprintf("/v3/webhook/%s", webhookID), resp)
+	err := client.GETContext(ctx, fmt.Sprintf("/v3/webhook/%s", webhookID), resp)
 	if err != nil {
+		if hc.RemoveFromStateIfNotFound(ctx, d, "kion_webhook", err) {
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read Webhook",
@@ -210,6 +251,11 @@ func resourceWebhookRead(ctx context.Context, d *schema.ResourceData, m interfac
 		return diag.Errorf("failed to normalize request_headers: %v", err)
 	}
 
+	// Headers set through request_headers_wo are kept out of the state.
+	if _, ok := d.GetOk("request_headers_wo_version"); ok {
+		normalizedRequestHeaders = ""
+	}
+
 	// Set fields based on the API response
 	diags = append(diags, hc.SafeSet(d, "callout_url", webhook.CalloutURL, "Failed to set callout_url")...)
 	diags = append(diags, hc.SafeSet(d, "description", webhook.Description, "Failed to set description")...)
@@ -255,7 +301,7 @@ func resourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	if d.HasChange("name") || d.HasChange("callout_url") || d.HasChange("description") ||
 		d.HasChange("request_body") || d.HasChange("request_headers") || d.HasChange("request_method") ||
 		d.HasChange("should_send_secure_info") || d.HasChange("skip_ssl") || d.HasChange("timeout_in_seconds") ||
-		d.HasChange("use_request_headers") {
+		d.HasChange("use_request_headers") || d.HasChange("request_headers_wo_version") {
 
 		// Normalize JSON fields before sending the update
 		webhook.RequestBody, err = normalizeJSONString(webhook.RequestBody)
@@ -269,7 +315,7 @@ func resourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		}
 
 		// Send PATCH request to update the webhook
-		err := client.PATCH(fmt.Sprintf("/v3/webhook/%s", webhookID), webhook)
+		err := client.PATCHContext(ctx, fmt.Sprintf("/v3/webhook/%s", webhookID), webhook)
 		if diags := hc.HandleError(err); diags != nil {
 			return diags
 		}
@@ -303,7 +349,7 @@ func resourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interf
 				"owner_user_ids":       removedUserIDs,
 				"owner_user_group_ids": removedGroupIDs,
 			}
-			err := client.DELETE(fmt.Sprintf("/v3/webhook/%s/owner", webhookID), removalData)
+			err := client.DELETEContext(ctx, fmt.Sprintf("/v3/webhook/%s/owner", webhookID), removalData)
 			if diags := hc.HandleError(err); diags != nil {
 				return diags
 			}
@@ -315,7 +361,7 @@ func resourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interf
 				"owner_user_ids":       addedUserIDs,
 				"owner_user_group_ids": addedGroupIDs,
 			}
-			_, err := client.POST(fmt.Sprintf("/v3/webhook/%s/owner", webhookID), additionData)
+			_, err := client.POSTContext(ctx, fmt.Sprintf("/v3/webhook/%s/owner", webhookID), additionData)
 			if diags := hc.HandleError(err); diags != nil {
 				return diags
 			}
@@ -331,7 +377,7 @@ func resourceWebhookDelete(ctx context.Context, d *schema.ResourceData, m interf
 
 	webhookID := d.Id()
 
-	err := client.DELETE(fmt.Sprintf("/v3/webhook/%s", webhookID), nil)
+	err := client.DELETEContext(ctx, fmt.Sprintf("/v3/webhook/%s", webhookID), nil)
 	if diags := hc.HandleError(err); diags != nil {
 		return diags
 	}
@@ -343,17 +389,16 @@ func resourceWebhookDelete(ctx context.Context, d *schema.ResourceData, m interf
 
 // resourceWebhookImport handles the import of existing webhook resources into Terraform.
 func resourceWebhookImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	webhookID := d.Id()
-
-	if err := d.Set("id", webhookID); err != nil {
-		return nil, fmt.Errorf("failed to set webhook ID: %v", err)
-	}
-
+	// The import ID is the webhook ID, which the refresh after the import
+	// reads the webhook with.
 	return []*schema.ResourceData{d}, nil
 }
 
 // normalizeJSONString minifies JSON string by removing whitespace and preventing terraform changes due to whitespace changes.
 func normalizeJSONString(input string) (string, error) {
+	if input == "" {
+		return "", nil
+	}
 	var compacted bytes.Buffer
 	err := json.Compact(&compacted, []byte(input))
 	if err != nil {